@@ -0,0 +1,205 @@
+package pterodactyl
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// SetAllocationNotes - Changes the notes of an allocation assigned to a server through the client API
+func (c *Client) SetAllocationNotes(identifier string, allocationID int32, notes string) (Allocation, error) {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/client/servers/%s/network/allocations/%d", c.HostURL, identifier, allocationID), c.prepareBody(map[string]string{"notes": notes}))
+	if err != nil {
+		return Allocation{}, err
+	}
+
+	body, err := c.doRequest(req, c.clientToken())
+	if err != nil {
+		return Allocation{}, err
+	}
+
+	// The client API names the alias ip_alias and only returns allocations assigned to the server
+	var response struct {
+		Attributes struct {
+			ID    int32  `json:"id"`
+			IP    string `json:"ip"`
+			Alias string `json:"ip_alias"`
+			Port  int32  `json:"port"`
+			Notes string `json:"notes"`
+		} `json:"attributes"`
+	}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return Allocation{}, err
+	}
+
+	allocation := Allocation{
+		ID:       response.Attributes.ID,
+		IP:       response.Attributes.IP,
+		Alias:    response.Attributes.Alias,
+		Port:     response.Attributes.Port,
+		Notes:    response.Attributes.Notes,
+		Assigned: true,
+	}
+
+	return allocation, nil
+}
+
+// AllocationAliasReport - Outcome of RenameAllocationAliases
+type AllocationAliasReport struct {
+	// Renamed - Allocations recreated with the new alias
+	Renamed []Allocation
+	// Unchanged - Allocations that already had the new alias
+	Unchanged []Allocation
+	// Assigned - Allocations in use by a server, which keep their old alias
+	Assigned []Allocation
+	// Skipped - Allocations on ports the panel would not accept again, left in place with their old alias
+	Skipped []Allocation
+	// Lost - Allocations deleted but not recreated because a request failed
+	Lost []Allocation
+	// Remaining - Allocations left in place with their old alias because the rename stopped at a failure
+	Remaining []Allocation
+}
+
+// RenameAllocationAliases - Sets the alias of every allocation of an IP on a node
+//
+// The application API cannot edit allocations, so unassigned allocations are deleted and recreated
+// with the new alias one range at a time. Allocations assigned to a server cannot be deleted and are
+// reported instead. The rename stops at the first failure, so at most one range ends up in Lost.
+func (c *Client) RenameAllocationAliases(nodeID int32, ip string, alias string) (AllocationAliasReport, error) {
+	allocations, err := c.GetNodeAllocations(nodeID)
+	if err != nil {
+		return AllocationAliasReport{}, err
+	}
+
+	var report AllocationAliasReport
+	byIP := map[string]map[int32]Allocation{}
+	for _, allocation := range allocations {
+		if !allocationMatchesIP(allocation, ip) {
+			continue
+		}
+
+		switch {
+		case allocation.Alias == alias:
+			report.Unchanged = append(report.Unchanged, allocation)
+		case allocation.Assigned:
+			report.Assigned = append(report.Assigned, allocation)
+		case (PortRange{Start: allocation.Port, End: allocation.Port}).Validate() != nil:
+			report.Skipped = append(report.Skipped, allocation)
+		default:
+			if byIP[allocation.IP] == nil {
+				byIP[allocation.IP] = map[int32]Allocation{}
+			}
+			byIP[allocation.IP][allocation.Port] = allocation
+		}
+	}
+
+	ips := make([]string, 0, len(byIP))
+	for allocationIP := range byIP {
+		ips = append(ips, allocationIP)
+	}
+	sort.Strings(ips)
+
+	for _, allocationIP := range ips {
+		existing := byIP[allocationIP]
+		ports := make([]int32, 0, len(existing))
+		for port := range existing {
+			ports = append(ports, port)
+		}
+
+		for _, portRange := range contiguousPortRanges(ports) {
+			for _, chunk := range portRange.Chunks() {
+				if err := c.renameAllocationRange(nodeID, allocationIP, alias, chunk, existing, &report); err != nil {
+					report.Remaining = remainingAllocations(byIP, report)
+					return report, err
+				}
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// remainingAllocations - Returns the allocations to rename that were neither renamed nor lost
+func remainingAllocations(byIP map[string]map[int32]Allocation, report AllocationAliasReport) []Allocation {
+	handled := map[string]bool{}
+	for _, allocation := range append(append([]Allocation(nil), report.Renamed...), report.Lost...) {
+		handled[fmt.Sprintf("%s:%d", allocation.IP, allocation.Port)] = true
+	}
+
+	var remaining []Allocation
+	for _, allocations := range byIP {
+		for _, allocation := range allocations {
+			if !handled[fmt.Sprintf("%s:%d", allocation.IP, allocation.Port)] {
+				remaining = append(remaining, allocation)
+			}
+		}
+	}
+	sort.Slice(remaining, func(i, j int) bool {
+		if remaining[i].IP != remaining[j].IP {
+			return remaining[i].IP < remaining[j].IP
+		}
+		return remaining[i].Port < remaining[j].Port
+	})
+
+	return remaining
+}
+
+// renameAllocationRange - Deletes the allocations of a range and recreates them with an alias
+func (c *Client) renameAllocationRange(nodeID int32, ip string, alias string, portRange PortRange, existing map[int32]Allocation, report *AllocationAliasReport) error {
+	var deleted []Allocation
+	for port := portRange.Start; port <= portRange.End; port++ {
+		allocation := existing[port]
+		if err := c.DeleteAllocation(nodeID, allocation.ID); err != nil {
+			// Recreate what was already deleted, the ports from here on keep their old alias
+			if len(deleted) > 0 {
+				c.recreateAllocations(nodeID, ip, alias, PortRange{Start: portRange.Start, End: port - 1}, deleted, report)
+			}
+			return fmt.Errorf("deleting allocation %s:%d: %w", ip, port, err)
+		}
+		deleted = append(deleted, allocation)
+	}
+
+	return c.recreateAllocations(nodeID, ip, alias, portRange, deleted, report)
+}
+
+// recreateAllocations - Creates a deleted range again with an alias, recording the allocations as lost when it fails
+func (c *Client) recreateAllocations(nodeID int32, ip string, alias string, portRange PortRange, deleted []Allocation, report *AllocationAliasReport) error {
+	request := PartialAllocation{IP: ip, Alias: alias}
+	request.AddPorts(portRange)
+
+	created, err := c.CreateAllocation(nodeID, request)
+	if err != nil {
+		report.Lost = append(report.Lost, deleted...)
+		return fmt.Errorf("recreating allocations %s:%s: %w", ip, portRange, err)
+	}
+
+	for _, allocation := range created {
+		if allocation.IP == ip && portRange.Contains(allocation.Port) {
+			report.Renamed = append(report.Renamed, allocation)
+		}
+	}
+
+	return nil
+}
+
+// contiguousPortRanges - Collapses ports into the fewest ranges that cover them
+func contiguousPortRanges(ports []int32) []PortRange {
+	sorted := append([]int32(nil), ports...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var ranges []PortRange
+	for _, port := range sorted {
+		last := len(ranges) - 1
+		if last >= 0 && port <= ranges[last].End+1 {
+			if port > ranges[last].End {
+				ranges[last].End = port
+			}
+			continue
+		}
+		ranges = append(ranges, PortRange{Start: port, End: port})
+	}
+
+	return ranges
+}
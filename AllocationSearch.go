@@ -0,0 +1,137 @@
+package pterodactyl
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+)
+
+// AllocationQuery - Criteria for searching allocations, unset fields match every allocation
+type AllocationQuery struct {
+	// IP - Exact IP or CIDR block
+	IP       string
+	Ports    *PortRange
+	Alias    string
+	Assigned *bool
+}
+
+// Matches - Reports whether an allocation satisfies the query
+func (q AllocationQuery) Matches(allocation Allocation) bool {
+	if q.IP != "" && !allocationMatchesIP(allocation, q.IP) {
+		return false
+	}
+	if q.Ports != nil && !q.Ports.Contains(allocation.Port) {
+		return false
+	}
+	if q.Alias != "" && allocation.Alias != q.Alias {
+		return false
+	}
+	if q.Assigned != nil && allocation.Assigned != *q.Assigned {
+		return false
+	}
+	return true
+}
+
+// NodeAllocation - Allocation together with the node it belongs to
+type NodeAllocation struct {
+	NodeID int32
+	Allocation
+}
+
+// FindAllocations - Returns the allocations of a node matching a query
+func (c *Client) FindAllocations(nodeID int32, query AllocationQuery) ([]Allocation, error) {
+	filters := url.Values{}
+	if query.IP != "" && net.ParseIP(query.IP) != nil {
+		filters.Set("filter[ip]", query.IP)
+	}
+
+	allocations, err := c.getNodeAllocations(nodeID, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	matching := make([]Allocation, 0)
+	for _, allocation := range allocations {
+		if query.Matches(allocation) {
+			matching = append(matching, allocation)
+		}
+	}
+
+	return matching, nil
+}
+
+// FindAllocationsOnNodes - Returns the allocations of several nodes matching a query
+func (c *Client) FindAllocationsOnNodes(nodeIDs []int32, query AllocationQuery) ([]NodeAllocation, error) {
+	matching := make([]NodeAllocation, 0)
+	for _, nodeID := range nodeIDs {
+		allocations, err := c.FindAllocations(nodeID, query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, allocation := range allocations {
+			matching = append(matching, NodeAllocation{NodeID: nodeID, Allocation: allocation})
+		}
+	}
+
+	return matching, nil
+}
+
+// FreeAllocationOptions - Options for FindFreeAllocations
+type FreeAllocationOptions struct {
+	// IP - Only consider allocations of this IP or CIDR block
+	IP string
+	// Ports - Only consider ports in this range
+	Ports *PortRange
+	// Contiguous - Require consecutive ports on the same IP
+	Contiguous bool
+}
+
+// FindFreeAllocations - Returns unassigned allocations of a node, ordered by IP and port
+func (c *Client) FindFreeAllocations(nodeID int32, count int, opts FreeAllocationOptions) ([]Allocation, error) {
+	if count <= 0 {
+		return nil, fmt.Errorf("invalid allocation count %d", count)
+	}
+
+	assigned := false
+	free, err := c.FindAllocations(nodeID, AllocationQuery{IP: opts.IP, Ports: opts.Ports, Assigned: &assigned})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(free, func(i, j int) bool {
+		if free[i].IP != free[j].IP {
+			return compareIPs(free[i].IP, free[j].IP) < 0
+		}
+		return free[i].Port < free[j].Port
+	})
+
+	if !opts.Contiguous {
+		if len(free) < count {
+			return nil, fmt.Errorf("node %d has %d free allocations, %d requested", nodeID, len(free), count)
+		}
+		return free[:count], nil
+	}
+
+	start := 0
+	for i := range free {
+		if i > 0 && (free[i].IP != free[i-1].IP || free[i].Port != free[i-1].Port+1) {
+			start = i
+		}
+		if i-start+1 == count {
+			return free[start : i+1], nil
+		}
+	}
+
+	return nil, fmt.Errorf("node %d has no %d contiguous free allocations", nodeID, count)
+}
+
+func compareIPs(a, b string) int {
+	ipA, ipB := net.ParseIP(a), net.ParseIP(b)
+	if ipA == nil || ipB == nil {
+		return bytes.Compare([]byte(a), []byte(b))
+	}
+	return bytes.Compare(ipA.To16(), ipB.To16())
+}
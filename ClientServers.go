@@ -0,0 +1,60 @@
+package pterodactyl
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SendServerCommand - Sends a command to the console of a running server through the client API
+func (c *Client) SendServerCommand(identifier string, command string) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/client/servers/%s/command", c.HostURL, identifier), c.prepareBody(map[string]string{"command": command}))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req, c.clientToken())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetServerPowerState - Sends a power signal (start, stop, restart or kill) to a server through the client API
+func (c *Client) SetServerPowerState(identifier string, signal string) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/client/servers/%s/power", c.HostURL, identifier), c.prepareBody(map[string]string{"signal": signal}))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req, c.clientToken())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetServerResources - Returns the power state and resource usage of a server through the client API
+func (c *Client) GetServerResources(identifier string) (ServerResources, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/client/servers/%s/resources", c.HostURL, identifier), nil)
+	if err != nil {
+		return ServerResources{}, err
+	}
+
+	body, err := c.doRequest(req, c.clientToken())
+	if err != nil {
+		return ServerResources{}, err
+	}
+
+	var response ServerResourcesResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return ServerResources{}, err
+	}
+
+	resources := response.Attributes
+
+	return resources, nil
+}
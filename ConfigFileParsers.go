@@ -0,0 +1,416 @@
+package pterodactyl
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// typedValue - Converts a replacement into the scalar Wings writes, strings holding integers become integers
+func typedValue(value interface{}) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.ScalarNode}
+	switch v := value.(type) {
+	case nil:
+		node.Tag, node.Value = "!!null", "null"
+	case bool:
+		node.Tag, node.Value = "!!bool", strconv.FormatBool(v)
+	case float64:
+		if v == float64(int64(v)) {
+			node.Tag, node.Value = "!!int", strconv.FormatInt(int64(v), 10)
+		} else {
+			node.Tag, node.Value = "!!float", strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	case string:
+		// Wings only writes a boolean when the egg gives one, other strings become integers when they parse as one
+		trimmed := strings.Trim(v, `"`)
+		if i, err := strconv.ParseInt(trimmed, 10, 64); err == nil {
+			node.Tag, node.Value = "!!int", strconv.FormatInt(i, 10)
+		} else {
+			node.Tag, node.Value = "!!str", trimmed
+		}
+	default:
+		node.Tag, node.Value = "!!str", configReplacement{value: value}.String()
+	}
+	return node
+}
+
+// nodePath - Splits a dotted path into segments, "name[0]" becomes the segments "name" and "0"
+func nodePath(match string) []string {
+	var path []string
+	for _, segment := range strings.Split(match, ".") {
+		open := strings.IndexByte(segment, '[')
+		if open <= 0 || pathIndexes.ReplaceAllString(segment[open:], "") != "" {
+			path = append(path, segment)
+			continue
+		}
+
+		path = append(path, segment[:open])
+		for _, index := range pathIndexes.FindAllStringSubmatch(segment[open:], -1) {
+			path = append(path, index[1])
+		}
+	}
+	return path
+}
+
+var pathIndexes = regexp.MustCompile(`\[(\d+)\]`)
+
+// applyNodeReplacement - Sets the value at a dotted path, "*" matches every element of a list
+func applyNodeReplacement(node *yaml.Node, path []string, replacement configReplacement) {
+	if len(path) == 0 {
+		replaceNodeValue(node, replacement)
+		return
+	}
+
+	segment := path[0]
+	switch node.Kind {
+	case yaml.SequenceNode:
+		if segment == "*" {
+			for _, child := range node.Content {
+				applyNodeReplacement(child, path[1:], replacement)
+			}
+			return
+		}
+		if index, err := strconv.Atoi(segment); err == nil && index >= 0 && index < len(node.Content) {
+			applyNodeReplacement(node.Content[index], path[1:], replacement)
+		}
+		return
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if segment == "*" || node.Content[i].Value == segment {
+				applyNodeReplacement(node.Content[i+1], path[1:], replacement)
+				if segment != "*" {
+					return
+				}
+			}
+		}
+		if segment == "*" || replacement.ifValue != "" {
+			return
+		}
+	default:
+		if segment == "*" || replacement.ifValue != "" {
+			return
+		}
+		*node = yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	}
+
+	child := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: segment}, child)
+	applyNodeReplacement(child, path[1:], replacement)
+}
+
+func replaceNodeValue(node *yaml.Node, replacement configReplacement) {
+	value := typedValue(replacement.value)
+
+	if replacement.ifValue != "" {
+		if node.Kind != yaml.ScalarNode {
+			return
+		}
+		if strings.HasPrefix(replacement.ifValue, "regex:") {
+			pattern, err := regexp.Compile(strings.TrimPrefix(replacement.ifValue, "regex:"))
+			if err != nil || !pattern.MatchString(node.Value) {
+				return
+			}
+			value = typedValue(pattern.ReplaceAllString(node.Value, replacement.String()))
+		} else if node.Value != replacement.ifValue {
+			return
+		}
+	}
+
+	node.Kind, node.Tag, node.Value, node.Content, node.Style = value.Kind, value.Tag, value.Value, nil, 0
+}
+
+// parseNodeDocument - Parses YAML or JSON contents, empty contents become an empty mapping
+func parseNodeDocument(contents []byte) (*yaml.Node, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(contents, &document); err != nil {
+		return nil, err
+	}
+	if document.Kind != yaml.DocumentNode || len(document.Content) == 0 {
+		document = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+
+	return &document, nil
+}
+
+func renderYAMLFile(contents []byte, replacements []configReplacement) ([]byte, error) {
+	document, err := parseNodeDocument(contents)
+	if err != nil {
+		return nil, err
+	}
+	for _, replacement := range replacements {
+		applyNodeReplacement(document.Content[0], nodePath(replacement.match), replacement)
+	}
+
+	var output bytes.Buffer
+	encoder := yaml.NewEncoder(&output)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
+
+func renderJSONFile(contents []byte, replacements []configReplacement) ([]byte, error) {
+	document, err := parseNodeDocument(contents)
+	if err != nil {
+		return nil, err
+	}
+	for _, replacement := range replacements {
+		applyNodeReplacement(document.Content[0], nodePath(replacement.match), replacement)
+	}
+
+	var compact bytes.Buffer
+	if err := writeNodeJSON(&compact, document.Content[0]); err != nil {
+		return nil, err
+	}
+
+	var output bytes.Buffer
+	if err := json.Indent(&output, compact.Bytes(), "", "    "); err != nil {
+		return nil, err
+	}
+	output.WriteByte('\n')
+
+	return output.Bytes(), nil
+}
+
+// writeNodeJSON - Encodes a node as JSON, keeping the order of the keys
+func writeNodeJSON(w *bytes.Buffer, node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return writeNodeJSON(w, node.Alias)
+	case yaml.MappingNode:
+		w.WriteByte('{')
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			key, _ := json.Marshal(node.Content[i].Value)
+			w.Write(key)
+			w.WriteByte(':')
+			if err := writeNodeJSON(w, node.Content[i+1]); err != nil {
+				return err
+			}
+		}
+		w.WriteByte('}')
+	case yaml.SequenceNode:
+		w.WriteByte('[')
+		for i, child := range node.Content {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			if err := writeNodeJSON(w, child); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!null":
+			w.WriteString("null")
+		case "!!bool", "!!int", "!!float":
+			w.WriteString(node.Value)
+		default:
+			value, err := json.Marshal(node.Value)
+			if err != nil {
+				return err
+			}
+			w.Write(value)
+		}
+	default:
+		return errors.New("unsupported JSON value")
+	}
+
+	return nil
+}
+
+// xmlNode - Element, text, comment or other token of an XML document
+type xmlNode struct {
+	token    xml.Token
+	name     xml.Name
+	attrs    []xml.Attr
+	children []*xmlNode
+}
+
+func (n *xmlNode) isElement() bool {
+	return n.token == nil
+}
+
+func (n *xmlNode) text() string {
+	var text strings.Builder
+	for _, child := range n.children {
+		if data, ok := child.token.(xml.CharData); ok {
+			text.Write(data)
+		}
+	}
+	return text.String()
+}
+
+func (n *xmlNode) setText(value string) {
+	children := n.children[:0]
+	for _, child := range n.children {
+		if _, ok := child.token.(xml.CharData); !ok {
+			children = append(children, child)
+		}
+	}
+	n.children = append(children, &xmlNode{token: xml.CharData(value)})
+}
+
+func (n *xmlNode) setAttr(key, value string) {
+	for i, attr := range n.attrs {
+		if attr.Name.Local == key && attr.Name.Space == "" {
+			n.attrs[i].Value = value
+			return
+		}
+	}
+	n.attrs = append(n.attrs, xml.Attr{Name: xml.Name{Local: key}, Value: value})
+}
+
+func parseXMLDocument(contents []byte) (*xmlNode, error) {
+	document := &xmlNode{}
+	stack := []*xmlNode{document}
+
+	decoder := xml.NewDecoder(bytes.NewReader(contents))
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		parent := stack[len(stack)-1]
+		switch t := token.(type) {
+		case xml.StartElement:
+			element := &xmlNode{name: t.Name, attrs: t.Attr}
+			parent.children = append(parent.children, element)
+			stack = append(stack, element)
+		case xml.EndElement:
+			if len(stack) == 1 {
+				return nil, errors.New("unexpected closing element " + t.Name.Local)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			parent.children = append(parent.children, &xmlNode{token: xml.CopyToken(token)})
+		}
+	}
+
+	return document, nil
+}
+
+func (n *xmlNode) root() *xmlNode {
+	for _, child := range n.children {
+		if child.isElement() {
+			return child
+		}
+	}
+	return nil
+}
+
+// find - Elements below the node matching a slash separated path, creating missing elements when requested
+func (n *xmlNode) find(path []string, create bool) []*xmlNode {
+	if len(path) == 0 {
+		return []*xmlNode{n}
+	}
+
+	var found []*xmlNode
+	for _, child := range n.children {
+		if child.isElement() && (path[0] == "*" || xmlName(child.name) == path[0]) {
+			found = append(found, child.find(path[1:], create)...)
+		}
+	}
+	if len(found) == 0 && create && path[0] != "*" {
+		child := &xmlNode{name: xml.Name{Local: path[0]}}
+		n.children = append(n.children, child)
+		found = child.find(path[1:], create)
+	}
+
+	return found
+}
+
+func xmlName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+var (
+	xmlAttributeValue = regexp.MustCompile(`^\[([\w]+)='(.*)'\]$`)
+	xmlTextEscaper    = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	xmlAttrEscaper    = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+)
+
+func (n *xmlNode) write(w *bytes.Buffer) {
+	switch t := n.token.(type) {
+	case nil:
+		w.WriteString("<" + xmlName(n.name))
+		for _, attr := range n.attrs {
+			w.WriteString(" " + xmlName(attr.Name) + `="` + xmlAttrEscaper.Replace(attr.Value) + `"`)
+		}
+		if len(n.children) == 0 {
+			w.WriteString("/>")
+			return
+		}
+		w.WriteByte('>')
+		for _, child := range n.children {
+			child.write(w)
+		}
+		w.WriteString("</" + xmlName(n.name) + ">")
+	case xml.CharData:
+		w.WriteString(xmlTextEscaper.Replace(string(t)))
+	case xml.Comment:
+		w.WriteString("<!--" + string(t) + "-->")
+	case xml.ProcInst:
+		w.WriteString("<?" + t.Target + " " + string(t.Inst) + "?>")
+	case xml.Directive:
+		w.WriteString("<!" + string(t) + ">")
+	}
+}
+
+// renderXMLFile - Sets element text, or an attribute for [name='value'] replacements, at a dotted path starting at the root
+func renderXMLFile(contents []byte, replacements []configReplacement) ([]byte, error) {
+	document, err := parseXMLDocument(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, replacement := range replacements {
+		path := strings.Split(replacement.match, ".")
+		if document.root() == nil {
+			document.children = append(document.children, &xmlNode{name: xml.Name{Local: path[0]}})
+		}
+
+		// The first segment names the root element, elements are only ever created below it
+		value := replacement.String()
+		create := replacement.ifValue == "" && !strings.Contains(replacement.match, "*") && xmlName(document.root().name) == path[0]
+		for _, element := range document.find(path, create) {
+			if replacement.ifValue != "" && element.text() != replacement.ifValue {
+				continue
+			}
+			if matches := xmlAttributeValue.FindStringSubmatch(value); matches != nil {
+				element.setAttr(matches[1], matches[2])
+				continue
+			}
+			element.setText(value)
+		}
+	}
+
+	var output bytes.Buffer
+	for _, child := range document.children {
+		child.write(&output)
+	}
+
+	return output.Bytes(), nil
+}
@@ -0,0 +1,341 @@
+package pterodactyl
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Configuration file parsers supported by Wings
+const (
+	ConfigParserFile       = "file"
+	ConfigParserProperties = "properties"
+	ConfigParserYAML       = "yaml"
+	ConfigParserJSON       = "json"
+	ConfigParserINI        = "ini"
+	ConfigParserXML        = "xml"
+)
+
+// ConfigEnvironment - Values available to the placeholders of egg configuration files
+type ConfigEnvironment struct {
+	UUID        string
+	Environment Environment
+	Limits      Limits
+	OOMDisabled bool
+	IP          string
+	Port        int32
+	// Config - Values for {{config.X}} placeholders, which Wings resolves from its own configuration
+	Config map[string]string
+}
+
+// NewConfigEnvironment - Builds the placeholder values of a server and its primary allocation
+func NewConfigEnvironment(server Server, allocation Allocation) ConfigEnvironment {
+	return ConfigEnvironment{
+		UUID:        server.UUID,
+		Environment: server.Container.Environment,
+		Limits:      server.Limits,
+		IP:          allocation.IP,
+		Port:        allocation.Port,
+		Config: map[string]string{
+			"docker.interface":         "172.18.0.1",
+			"docker.network.interface": "172.18.0.1",
+		},
+	}
+}
+
+// env - Environment variables of the server including the ones Wings adds for every server
+func (e ConfigEnvironment) env() map[string]string {
+	env := map[string]string{}
+	for key := range e.Environment {
+		value, _ := e.Environment.GetString(key)
+		env[key] = value
+	}
+
+	env["SERVER_MEMORY"] = strconv.FormatInt(int64(e.Limits.Memory), 10)
+	env["SERVER_IP"] = e.IP
+	env["SERVER_PORT"] = strconv.FormatInt(int64(e.Port), 10)
+	if e.UUID != "" {
+		env["P_SERVER_UUID"] = e.UUID
+	}
+
+	return env
+}
+
+// lookup - Resolves a placeholder key such as server.build.default.port or env.SERVER_JARFILE
+func (e ConfigEnvironment) lookup(key string) (string, bool) {
+	if strings.HasPrefix(key, "config.") {
+		value, ok := e.Config[strings.TrimPrefix(key, "config.")]
+		return value, ok
+	}
+	if strings.HasPrefix(key, "env.") {
+		key = "server.build.env." + strings.TrimPrefix(key, "env.")
+	}
+	if strings.HasPrefix(key, "server.build.env.") {
+		value, ok := e.env()[strings.TrimPrefix(key, "server.build.env.")]
+		return value, ok
+	}
+
+	format := func(i int32) (string, bool) { return strconv.FormatInt(int64(i), 10), true }
+	switch key {
+	case "server.uuid":
+		return e.UUID, e.UUID != ""
+	case "server.build.default.ip":
+		return e.IP, true
+	case "server.build.default.port":
+		return format(e.Port)
+	case "server.build.memory":
+		return format(e.Limits.Memory)
+	case "server.build.swap":
+		return format(e.Limits.Swap)
+	case "server.build.disk":
+		return format(e.Limits.Disk)
+	case "server.build.io":
+		return format(e.Limits.IO)
+	case "server.build.cpu":
+		return format(e.Limits.CPU)
+	case "server.build.threads":
+		return format(e.Limits.Threads)
+	case "server.build.oom_disabled":
+		return strconv.FormatBool(e.OOMDisabled), true
+	}
+
+	return "", false
+}
+
+var configPlaceholder = regexp.MustCompile(`{{([\w.-]*)}}`)
+
+// replacePlaceholders - Replaces placeholders the way the panel does, unknown server and env values become empty
+func (e ConfigEnvironment) replacePlaceholders(value string) string {
+	return configPlaceholder.ReplaceAllStringFunc(value, func(placeholder string) string {
+		key := configPlaceholder.FindStringSubmatch(placeholder)[1]
+		if !strings.HasPrefix(key, "server.") && !strings.HasPrefix(key, "env.") && !strings.HasPrefix(key, "config.") {
+			return placeholder
+		}
+
+		resolved, ok := e.lookup(key)
+		if !ok && strings.HasPrefix(key, "config.") {
+			return placeholder
+		}
+		return resolved
+	})
+}
+
+// configReplacement - Single find rule of a configuration file
+type configReplacement struct {
+	match   string
+	ifValue string
+	value   interface{}
+}
+
+func (r configReplacement) String() string {
+	switch v := r.value.(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	}
+	return fmt.Sprint(r.value)
+}
+
+// configReplacements - Expands the find rules of a configuration file, a map value replaces only matching existing values
+func configReplacements(find map[string]interface{}, env ConfigEnvironment) []configReplacement {
+	keys := make([]string, 0, len(find))
+	for key := range find {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	resolve := func(value interface{}) interface{} {
+		if s, ok := value.(string); ok {
+			return env.replacePlaceholders(s)
+		}
+		return value
+	}
+
+	var replacements []configReplacement
+	for _, key := range keys {
+		conditional, ok := find[key].(map[string]interface{})
+		if !ok {
+			replacements = append(replacements, configReplacement{match: key, value: resolve(find[key])})
+			continue
+		}
+
+		ifValues := make([]string, 0, len(conditional))
+		for ifValue := range conditional {
+			ifValues = append(ifValues, ifValue)
+		}
+		sort.Strings(ifValues)
+		for _, ifValue := range ifValues {
+			replacements = append(replacements, configReplacement{match: key, ifValue: ifValue, value: resolve(conditional[ifValue])})
+		}
+	}
+
+	return replacements
+}
+
+// RenderConfigFile - Applies the find rules of an egg configuration file to its contents, producing the file Wings would write
+func RenderConfigFile(contents []byte, file EggConfigFile, env ConfigEnvironment) ([]byte, error) {
+	replacements := configReplacements(file.Find, env)
+
+	switch file.Parser {
+	case ConfigParserFile:
+		return renderPlainFile(contents, replacements), nil
+	case ConfigParserProperties:
+		return renderPropertiesFile(contents, replacements), nil
+	case ConfigParserINI:
+		return renderINIFile(contents, replacements), nil
+	case ConfigParserYAML:
+		return renderYAMLFile(contents, replacements)
+	case ConfigParserJSON:
+		return renderJSONFile(contents, replacements)
+	case ConfigParserXML:
+		return renderXMLFile(contents, replacements)
+	}
+
+	return nil, fmt.Errorf("unsupported configuration parser %q", file.Parser)
+}
+
+// readLines - Splits contents into lines, dropping the trailing newline
+func readLines(contents []byte) []string {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	scanner.Buffer(make([]byte, 64*1024), len(contents)+1)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines
+}
+
+func joinLines(lines []string) []byte {
+	if len(lines) == 0 {
+		return []byte{}
+	}
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
+
+// renderPlainFile - Replaces every line starting with a match by the replacement value
+func renderPlainFile(contents []byte, replacements []configReplacement) []byte {
+	lines := readLines(contents)
+	for i, line := range lines {
+		for _, replacement := range replacements {
+			if strings.HasPrefix(line, replacement.match) {
+				lines[i] = replacement.String()
+			}
+		}
+	}
+
+	return joinLines(lines)
+}
+
+// propertiesKey - Key of a properties line, which ends at the first unescaped separator
+func propertiesKey(line string) (string, bool) {
+	trimmed := strings.TrimLeft(line, " \t\f")
+	if trimmed == "" || trimmed[0] == '#' || trimmed[0] == '!' {
+		return "", false
+	}
+
+	for i := 0; i < len(trimmed); i++ {
+		switch trimmed[i] {
+		case '\\':
+			i++
+		case '=', ':', ' ', '\t', '\f':
+			return trimmed[:i], true
+		}
+	}
+
+	return trimmed, true
+}
+
+func propertiesValue(line string, key string) string {
+	rest := strings.TrimLeft(line, " \t\f")[len(key):]
+	rest = strings.TrimLeft(rest, " \t\f")
+	if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+		rest = rest[1:]
+	}
+	return strings.TrimLeft(rest, " \t\f")
+}
+
+// renderPropertiesFile - Sets properties in place, appending the ones missing from the file
+func renderPropertiesFile(contents []byte, replacements []configReplacement) []byte {
+	lines := readLines(contents)
+	for _, replacement := range replacements {
+		found := false
+		for i, line := range lines {
+			key, ok := propertiesKey(line)
+			if !ok || key != replacement.match {
+				continue
+			}
+			found = true
+			if replacement.ifValue != "" && propertiesValue(line, key) != replacement.ifValue {
+				continue
+			}
+			lines[i] = key + "=" + replacement.String()
+		}
+		if !found && replacement.ifValue == "" {
+			lines = append(lines, replacement.match+"="+replacement.String())
+		}
+	}
+
+	return joinLines(lines)
+}
+
+// renderINIFile - Sets section.key values in place, creating missing keys and sections
+func renderINIFile(contents []byte, replacements []configReplacement) []byte {
+	lines := readLines(contents)
+	for _, replacement := range replacements {
+		section, key := "", replacement.match
+		if parts := strings.SplitN(replacement.match, ".", 2); len(parts) == 2 {
+			section, key = parts[0], parts[1]
+		}
+
+		current, sectionEnd, found := "", -1, false
+		if section == "" {
+			sectionEnd = 0
+		}
+		for i, line := range lines {
+			trimmed := strings.TrimSpace(line)
+			if strings.HasPrefix(trimmed, "[") && strings.HasSuffix(trimmed, "]") {
+				current = strings.TrimSpace(trimmed[1 : len(trimmed)-1])
+				if current == section {
+					sectionEnd = i + 1
+				}
+				continue
+			}
+			if current != section {
+				continue
+			}
+			if trimmed != "" && !strings.HasPrefix(trimmed, ";") && !strings.HasPrefix(trimmed, "#") {
+				sectionEnd = i + 1
+			}
+
+			name, value, ok := strings.Cut(trimmed, "=")
+			if !ok || strings.TrimSpace(name) != key {
+				continue
+			}
+			found = true
+			if replacement.ifValue != "" && strings.TrimSpace(value) != replacement.ifValue {
+				continue
+			}
+			lines[i] = key + " = " + replacement.String()
+		}
+
+		if found || replacement.ifValue != "" {
+			continue
+		}
+		line := key + " = " + replacement.String()
+		if sectionEnd < 0 {
+			if len(lines) > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, "["+section+"]", line)
+			continue
+		}
+		lines = append(lines[:sectionEnd], append([]string{line}, lines[sectionEnd:]...)...)
+	}
+
+	return joinLines(lines)
+}
@@ -0,0 +1,155 @@
+package pterodactyl
+
+import (
+	"testing"
+)
+
+func testConfigEnvironment() ConfigEnvironment {
+	return ConfigEnvironment{
+		UUID:        "d3aac109-e5a0-4331-b03e-3454f7e136dc",
+		Environment: Environment{"MAX_PLAYERS": "1", "MOTD": "Hello", "ONLINE": "true"},
+		Limits:      Limits{Memory: 1024},
+		IP:          "0.0.0.0",
+		Port:        25565,
+	}
+}
+
+func TestRenderConfigFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		parser   string
+		find     map[string]interface{}
+		contents string
+		want     string
+	}{
+		{
+			name:     "file replaces lines starting with the match",
+			parser:   ConfigParserFile,
+			find:     map[string]interface{}{"port=": "port={{server.build.default.port}}"},
+			contents: "motd=A\nport=1\n",
+			want:     "motd=A\nport=25565\n",
+		},
+		{
+			name:     "properties replaces and appends keys",
+			parser:   ConfigParserProperties,
+			find:     map[string]interface{}{"server-port": "{{server.build.default.port}}", "motd": "{{server.build.env.MOTD}}"},
+			contents: "server-port=1\n",
+			want:     "server-port=25565\nmotd=Hello\n",
+		},
+		{
+			name:     "ini sets keys in sections",
+			parser:   ConfigParserINI,
+			find:     map[string]interface{}{"server.port": "{{server.build.default.port}}"},
+			contents: "[server]\nport = 1\nname = test\n",
+			want:     "[server]\nport = 25565\nname = test\n",
+		},
+		{
+			name:     "yaml writes integers as integers",
+			parser:   ConfigParserYAML,
+			find:     map[string]interface{}{"players": "{{server.build.env.MAX_PLAYERS}}"},
+			contents: "players: 5\n",
+			want:     "players: 1\n",
+		},
+		{
+			name:     "yaml keeps boolean looking strings as strings",
+			parser:   ConfigParserYAML,
+			find:     map[string]interface{}{"online": "{{server.build.env.ONLINE}}"},
+			contents: "online: false\n",
+			want:     "online: \"true\"\n",
+		},
+		{
+			name:     "yaml writes booleans given by the egg",
+			parser:   ConfigParserYAML,
+			find:     map[string]interface{}{"online": true},
+			contents: "online: false\n",
+			want:     "online: true\n",
+		},
+		{
+			name:     "yaml updates indexed list elements",
+			parser:   ConfigParserYAML,
+			find:     map[string]interface{}{"listeners[0].query_port": "{{server.build.default.port}}"},
+			contents: "listeners:\n  - query_port: 1\n    host: a\n  - query_port: 2\n",
+			want:     "listeners:\n  - query_port: 25565\n    host: a\n  - query_port: 2\n",
+		},
+		{
+			name:     "yaml updates every list element",
+			parser:   ConfigParserYAML,
+			find:     map[string]interface{}{"listeners.*.host": "{{server.build.default.ip}}:{{server.build.default.port}}"},
+			contents: "listeners:\n  - host: a\n  - host: b\n",
+			want:     "listeners:\n  - host: 0.0.0.0:25565\n  - host: 0.0.0.0:25565\n",
+		},
+		{
+			name:     "json sets nested keys",
+			parser:   ConfigParserJSON,
+			find:     map[string]interface{}{"server.port": "{{server.build.default.port}}", "server.name": "{{server.build.env.MOTD}}"},
+			contents: `{"server":{"port":1,"name":"a"}}`,
+			want:     "{\n    \"server\": {\n        \"port\": 25565,\n        \"name\": \"Hello\"\n    }\n}\n",
+		},
+		{
+			name:     "json updates indexed list elements",
+			parser:   ConfigParserJSON,
+			find:     map[string]interface{}{"ports[1]": "{{server.build.default.port}}"},
+			contents: `{"ports":[1,2]}`,
+			want:     "{\n    \"ports\": [\n        1,\n        25565\n    ]\n}\n",
+		},
+		{
+			name:     "xml sets element text below the root",
+			parser:   ConfigParserXML,
+			find:     map[string]interface{}{"config.port": "{{server.build.default.port}}"},
+			contents: "<config><port>1</port></config>",
+			want:     "<config><port>25565</port></config>",
+		},
+		{
+			name:     "xml creates missing elements below the root",
+			parser:   ConfigParserXML,
+			find:     map[string]interface{}{"config.motd": "{{server.build.env.MOTD}}"},
+			contents: "<config><port>1</port></config>",
+			want:     "<config><port>1</port><motd>Hello</motd></config>",
+		},
+		{
+			name:     "xml never adds a second root",
+			parser:   ConfigParserXML,
+			find:     map[string]interface{}{"port": "1"},
+			contents: "<config><port>2</port></config>",
+			want:     "<config><port>2</port></config>",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file := EggConfigFile{Parser: test.parser, Find: test.find}
+			got, err := RenderConfigFile([]byte(test.contents), file, testConfigEnvironment())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != test.want {
+				t.Fatalf("got\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestNodePath(t *testing.T) {
+	tests := []struct {
+		match string
+		want  []string
+	}{
+		{"server.port", []string{"server", "port"}},
+		{"listeners[0].query_port", []string{"listeners", "0", "query_port"}},
+		{"grid[1][2]", []string{"grid", "1", "2"}},
+		{"listeners.*.host", []string{"listeners", "*", "host"}},
+		{"odd[key", []string{"odd[key"}},
+	}
+
+	for _, test := range tests {
+		got := nodePath(test.match)
+		if len(got) != len(test.want) {
+			t.Fatalf("%s: got %q, want %q", test.match, got, test.want)
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Fatalf("%s: got %q, want %q", test.match, got, test.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package pterodactyl
+
+import (
+	"fmt"
+	"time"
+)
+
+// Actions recorded in a DeprovisionSummary
+const (
+	DeprovisionSuspendServer  = "suspend_server"
+	DeprovisionTransferServer = "transfer_server"
+	DeprovisionDeleteServer   = "delete_server"
+	DeprovisionRemoveSubuser  = "remove_subuser"
+	DeprovisionDeleteUser     = "delete_user"
+)
+
+// DeprovisionOptions - Options for DeprovisionUser
+type DeprovisionOptions struct {
+	// SuspendServers - Suspend the servers of the user, ignored for servers that are deleted
+	SuspendServers bool
+	// DeleteServers - Delete the servers of the user
+	DeleteServers bool
+	// ForceDelete - Delete servers from the panel even when their daemon cannot be reached
+	ForceDelete bool
+	// TransferTo - User that becomes the owner of the servers, cannot be combined with DeleteServers
+	TransferTo int32
+	// RemoveSubusers - Remove the user from every server they are a subuser of, requires a client API key of an administrator
+	RemoveSubusers bool
+	// DryRun - Record the steps without changing anything
+	DryRun bool
+}
+
+// DeprovisionStep - Single change made, or planned in a dry run, by DeprovisionUser
+type DeprovisionStep struct {
+	Action string
+	// ServerID and Server - Server the step applies to, empty when deleting the user
+	ServerID int32
+	Server   string
+	Detail   string
+	// Done - The change was made, false in a dry run or when it failed
+	Done bool
+	Err  error
+	At   time.Time
+}
+
+// DeprovisionSummary - Outcome of DeprovisionUser
+type DeprovisionSummary struct {
+	User   User
+	DryRun bool
+	// Servers - Servers the user owned when deprovisioning started
+	Servers     []Server
+	Steps       []DeprovisionStep
+	UserDeleted bool
+}
+
+// Failed - Returns the steps that could not be carried out
+func (s DeprovisionSummary) Failed() []DeprovisionStep {
+	var failed []DeprovisionStep
+	for _, step := range s.Steps {
+		if step.Err != nil {
+			failed = append(failed, step)
+		}
+	}
+	return failed
+}
+
+// run - Records a step and carries it out unless this is a dry run
+func (s *DeprovisionSummary) run(step DeprovisionStep, change func() error) bool {
+	step.At = time.Now()
+	if !s.DryRun {
+		step.Err = change()
+		step.Done = step.Err == nil
+	}
+	s.Steps = append(s.Steps, step)
+
+	return step.Err == nil
+}
+
+// DeprovisionUser - Removes a user and deals with the servers they own
+//
+// The panel refuses to delete a user who still owns servers, so the user is only deleted when every
+// server was deleted or transferred. Failed steps are recorded in the summary and do not stop later steps.
+func (c *Client) DeprovisionUser(userID int32, opts DeprovisionOptions) (DeprovisionSummary, error) {
+	if opts.DeleteServers && opts.TransferTo != 0 {
+		return DeprovisionSummary{}, fmt.Errorf("servers cannot be both deleted and transferred")
+	}
+	if opts.TransferTo == userID {
+		return DeprovisionSummary{}, fmt.Errorf("cannot transfer servers of user %d to themselves", userID)
+	}
+
+	user, err := c.GetUser(userID, UserIncludeServers)
+	if err != nil {
+		return DeprovisionSummary{}, err
+	}
+
+	if opts.TransferTo != 0 {
+		if _, err := c.GetUser(opts.TransferTo); err != nil {
+			return DeprovisionSummary{}, err
+		}
+	}
+
+	summary := DeprovisionSummary{User: user, DryRun: opts.DryRun}
+	for _, serverData := range user.Relationships.Servers.Data {
+		summary.Servers = append(summary.Servers, serverData.Attributes)
+	}
+
+	owned := 0
+	deleted := map[int32]bool{}
+	for _, server := range summary.Servers {
+		step := DeprovisionStep{ServerID: server.ID, Server: server.Identifier}
+
+		if opts.SuspendServers && !opts.DeleteServers && !server.Suspended {
+			step.Action = DeprovisionSuspendServer
+			summary.run(step, func() error {
+				return c.SuspendServer(server.ID)
+			})
+		}
+
+		switch {
+		case opts.DeleteServers:
+			step.Action = DeprovisionDeleteServer
+			if summary.run(step, func() error {
+				return c.DeleteServer(server.ID, opts.ForceDelete)
+			}) {
+				deleted[server.ID] = true
+			} else {
+				owned++
+			}
+		case opts.TransferTo != 0:
+			step.Action = DeprovisionTransferServer
+			step.Detail = fmt.Sprintf("to user %d", opts.TransferTo)
+			if !summary.run(step, func() error {
+				_, err := c.UpdateServerDetails(server.ID, ServerDetails{
+					ExternalID:  server.ExternalID,
+					Name:        server.Name,
+					User:        opts.TransferTo,
+					Description: server.Description,
+				})
+				return err
+			}) {
+				owned++
+			}
+		default:
+			owned++
+		}
+	}
+
+	if opts.RemoveSubusers {
+		servers, err := c.GetServers()
+		if err != nil {
+			return summary, err
+		}
+
+		for _, server := range servers {
+			if server.User == user.ID || deleted[server.ID] {
+				continue
+			}
+
+			step := DeprovisionStep{Action: DeprovisionRemoveSubuser, ServerID: server.ID, Server: server.Identifier}
+			subusers, err := c.GetSubusers(server.Identifier)
+			if err != nil {
+				step.Err = err
+				step.At = time.Now()
+				summary.Steps = append(summary.Steps, step)
+				continue
+			}
+
+			for _, subuser := range subusers {
+				if subuser.UUID == user.UUID {
+					summary.run(step, func() error {
+						return c.DeleteSubuser(server.Identifier, user.UUID)
+					})
+					break
+				}
+			}
+		}
+	}
+
+	step := DeprovisionStep{Action: DeprovisionDeleteUser, Detail: user.Username}
+	if owned > 0 {
+		step.Err = fmt.Errorf("user %d still owns %d servers", user.ID, owned)
+		step.At = time.Now()
+		summary.Steps = append(summary.Steps, step)
+		return summary, nil
+	}
+	summary.UserDeleted = summary.run(step, func() error {
+		return c.DeleteUser(user.ID)
+	}) && !opts.DryRun
+
+	return summary, nil
+}
@@ -0,0 +1,112 @@
+package pterodactyl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ImageCatalog - Docker images keyed by label
+type ImageCatalog map[string]string
+
+// UnmarshalJSON - Decodes an image map, accepting the plain image lists of older panels
+func (c *ImageCatalog) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if bytes.HasPrefix(trimmed, []byte("[")) {
+		var images []string
+		if err := json.Unmarshal(trimmed, &images); err != nil {
+			return err
+		}
+		catalog := ImageCatalog{}
+		for _, image := range images {
+			catalog[image] = image
+		}
+		*c = catalog
+		return nil
+	}
+
+	var catalog map[string]string
+	if err := json.Unmarshal(trimmed, &catalog); err != nil {
+		return err
+	}
+	*c = catalog
+
+	return nil
+}
+
+// Images - Returns the docker images offered by an egg keyed by label, falling back to DockerImage on panels without image maps
+func (e Egg) Images() ImageCatalog {
+	if len(e.DockerImages) > 0 {
+		return e.DockerImages
+	}
+	if e.DockerImage != "" {
+		return ImageCatalog{e.DockerImage: e.DockerImage}
+	}
+	return ImageCatalog{}
+}
+
+// ImageByLabel - Returns the docker image of an egg with the given label, an empty label selects the default image
+func (e Egg) ImageByLabel(label string) (string, error) {
+	if label == "" && e.DockerImage != "" {
+		return e.DockerImage, nil
+	}
+
+	images := e.Images()
+	if image, ok := images[label]; ok {
+		return image, nil
+	}
+
+	labels := make([]string, 0, len(images))
+	for imageLabel := range images {
+		labels = append(labels, imageLabel)
+	}
+	sort.Strings(labels)
+
+	return "", fmt.Errorf("egg %d has no docker image labelled %q, available: %s", e.ID, label, strings.Join(labels, ", "))
+}
+
+// OffersImage - Reports whether an image is one of the docker images of an egg
+func (e Egg) OffersImage(image string) bool {
+	for _, offered := range e.Images() {
+		if offered == image {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateServerImage - Checks that the container image of a server is offered by its egg
+func ValidateServerImage(server Server, egg Egg) error {
+	if !egg.OffersImage(server.Container.Image) {
+		return fmt.Errorf("image %q of server %d is not offered by egg %d", server.Container.Image, server.ID, egg.ID)
+	}
+	return nil
+}
+
+// UseEggImage - Sets the egg and docker image of a new server, selecting the image by label
+func (p *PartialServer) UseEggImage(egg Egg, label string) error {
+	image, err := egg.ImageByLabel(label)
+	if err != nil {
+		return err
+	}
+
+	p.Egg = egg.ID
+	p.DockerImage = image
+
+	return nil
+}
+
+// UseEggImage - Sets the egg and docker image of a startup update, selecting the image by label
+func (s *ServerStartup) UseEggImage(egg Egg, label string) error {
+	image, err := egg.ImageByLabel(label)
+	if err != nil {
+		return err
+	}
+
+	s.Egg = egg.ID
+	s.Image = image
+
+	return nil
+}
@@ -0,0 +1,352 @@
+package pterodactyl
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Variables - Returns the variables of an egg requested with EggIncludeVariables
+func (e Egg) Variables() []EggVariable {
+	variablesData := e.Relationships.Variables.Data
+
+	variables := make([]EggVariable, len(variablesData))
+	for i, variableData := range variablesData {
+		variables[i] = variableData.Attributes
+	}
+
+	return variables
+}
+
+// VariableViolation - Rule of an egg variable that a value does not satisfy
+type VariableViolation struct {
+	Variable string
+	Rule     string
+	Message  string
+}
+
+// EnvironmentValidationError - Returned when an environment does not satisfy the rules of its egg variables
+type EnvironmentValidationError struct {
+	Violations []VariableViolation
+}
+
+func (e *EnvironmentValidationError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		messages[i] = fmt.Sprintf("%s: %s", violation.Variable, violation.Message)
+	}
+
+	return "invalid environment: " + strings.Join(messages, "; ")
+}
+
+// ValidateEnvironment - Evaluates the rules of every egg variable against an environment the way the panel does
+//
+// Rules that cannot be evaluated locally, such as unknown rules or regular expressions
+// Go does not support, are skipped and left to the panel.
+func ValidateEnvironment(variables []EggVariable, environment Environment) error {
+	var violations []VariableViolation
+	for _, variable := range variables {
+		// Rules such as string and boolean check the JSON type, so the raw value is validated next to its string form
+		raw := environment[variable.EnvVariable]
+		value, _ := environment.GetString(variable.EnvVariable)
+		violations = append(violations, variable.validate(raw, value, raw != nil && value != "")...)
+	}
+
+	if len(violations) > 0 {
+		return &EnvironmentValidationError{Violations: violations}
+	}
+
+	return nil
+}
+
+// Validate - Evaluates the rules of an egg variable against a single string value, an empty value counts as unset
+func (v EggVariable) Validate(value string) error {
+	violations := v.validate(value, value, value != "")
+	if len(violations) > 0 {
+		return &EnvironmentValidationError{Violations: violations}
+	}
+
+	return nil
+}
+
+type variableRule struct {
+	name       string
+	parameters []string
+	raw        string
+}
+
+func parseVariableRules(rules string) []variableRule {
+	var parsed []variableRule
+	for _, raw := range strings.Split(rules, "|") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+
+		name, parameters, hasParameters := strings.Cut(raw, ":")
+		rule := variableRule{name: strings.ToLower(name), raw: raw}
+		if hasParameters {
+			if rule.name == "regex" || rule.name == "not_regex" {
+				rule.parameters = []string{parameters}
+			} else {
+				rule.parameters = strings.Split(parameters, ",")
+			}
+		}
+		parsed = append(parsed, rule)
+	}
+
+	return parsed
+}
+
+func (v EggVariable) validate(raw interface{}, value string, present bool) []VariableViolation {
+	rules := parseVariableRules(v.Rules)
+
+	var nullable, numeric bool
+	for _, rule := range rules {
+		switch rule.name {
+		case "nullable":
+			nullable = true
+		case "integer", "numeric":
+			numeric = true
+		}
+	}
+
+	var violations []VariableViolation
+	fail := func(rule variableRule, format string, args ...interface{}) {
+		violations = append(violations, VariableViolation{
+			Variable: v.EnvVariable,
+			Rule:     rule.raw,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	for _, rule := range rules {
+		if !present {
+			switch rule.name {
+			case "required":
+				fail(rule, "is required")
+			case "string", "integer", "numeric", "boolean":
+				if !nullable {
+					fail(rule, "must be a %s", rule.name)
+				}
+			}
+			continue
+		}
+
+		switch rule.name {
+		case "string":
+			if _, ok := raw.(string); !ok {
+				fail(rule, "must be a string")
+			}
+		case "integer":
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				fail(rule, "must be an integer")
+			}
+		case "numeric":
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				fail(rule, "must be a number")
+			}
+		case "boolean":
+			if !isRuleBoolean(raw) {
+				fail(rule, "must be true or false")
+			}
+		case "alpha":
+			if !allRunes(value, unicode.IsLetter) {
+				fail(rule, "may only contain letters")
+			}
+		case "alpha_num":
+			if !allRunes(value, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsNumber(r) }) {
+				fail(rule, "may only contain letters and numbers")
+			}
+		case "alpha_dash":
+			if !allRunes(value, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsNumber(r) || r == '-' || r == '_' }) {
+				fail(rule, "may only contain letters, numbers, dashes and underscores")
+			}
+		case "max", "min", "size", "between":
+			limits, ok := parseRuleNumbers(rule.parameters)
+			if !ok || (rule.name == "between" && len(limits) != 2) || len(limits) == 0 {
+				continue
+			}
+			size, ok := ruleSize(value, numeric)
+			if !ok {
+				continue
+			}
+			unit := " characters"
+			if numeric {
+				unit = ""
+			}
+			switch {
+			case rule.name == "max" && size > limits[0]:
+				fail(rule, "may not be greater than %s%s", formatRuleNumber(limits[0]), unit)
+			case rule.name == "min" && size < limits[0]:
+				fail(rule, "must be at least %s%s", formatRuleNumber(limits[0]), unit)
+			case rule.name == "size" && size != limits[0]:
+				fail(rule, "must be %s%s", formatRuleNumber(limits[0]), unit)
+			case rule.name == "between" && (size < limits[0] || size > limits[1]):
+				fail(rule, "must be between %s and %s%s", formatRuleNumber(limits[0]), formatRuleNumber(limits[1]), unit)
+			}
+		case "digits", "digits_between":
+			limits, ok := parseRuleNumbers(rule.parameters)
+			if !ok || len(limits) == 0 || (rule.name == "digits_between" && len(limits) != 2) {
+				continue
+			}
+			length := float64(len(value))
+			switch {
+			case !allRunes(value, func(r rune) bool { return r >= '0' && r <= '9' }):
+				fail(rule, "must only contain digits")
+			case rule.name == "digits" && length != limits[0]:
+				fail(rule, "must be %s digits", formatRuleNumber(limits[0]))
+			case rule.name == "digits_between" && (length < limits[0] || length > limits[1]):
+				fail(rule, "must be between %s and %s digits", formatRuleNumber(limits[0]), formatRuleNumber(limits[1]))
+			}
+		case "in", "not_in":
+			found := false
+			for _, option := range rule.parameters {
+				if strings.Trim(option, `"`) == value {
+					found = true
+					break
+				}
+			}
+			if rule.name == "in" && !found {
+				fail(rule, "must be one of %s", strings.Join(rule.parameters, ", "))
+			}
+			if rule.name == "not_in" && found {
+				fail(rule, "may not be one of %s", strings.Join(rule.parameters, ", "))
+			}
+		case "regex", "not_regex":
+			if len(rule.parameters) == 0 {
+				continue
+			}
+			pattern, err := compilePHPRegex(rule.parameters[0])
+			if err != nil {
+				continue
+			}
+			matched := pattern.MatchString(value)
+			if rule.name == "regex" && !matched {
+				fail(rule, "does not match %s", rule.parameters[0])
+			}
+			if rule.name == "not_regex" && matched {
+				fail(rule, "may not match %s", rule.parameters[0])
+			}
+		case "url":
+			parsed, err := url.Parse(value)
+			if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+				fail(rule, "must be a valid URL")
+			}
+		case "ip", "ipv4", "ipv6":
+			ip := net.ParseIP(value)
+			switch {
+			case ip == nil:
+				fail(rule, "must be a valid IP address")
+			case rule.name == "ipv4" && ip.To4() == nil:
+				fail(rule, "must be a valid IPv4 address")
+			case rule.name == "ipv6" && ip.To4() != nil:
+				fail(rule, "must be a valid IPv6 address")
+			}
+		}
+	}
+
+	return violations
+}
+
+// isRuleBoolean - Reports whether a value passes the boolean rule, which only accepts true, false, 0, 1, "0" and "1"
+func isRuleBoolean(raw interface{}) bool {
+	switch v := raw.(type) {
+	case bool:
+		return true
+	case string:
+		return v == "0" || v == "1"
+	case json.Number:
+		return v == "0" || v == "1"
+	case float64:
+		return v == 0 || v == 1
+	case int:
+		return v == 0 || v == 1
+	case int32:
+		return v == 0 || v == 1
+	case int64:
+		return v == 0 || v == 1
+	}
+	return false
+}
+
+func allRunes(value string, allowed func(rune) bool) bool {
+	for _, r := range value {
+		if !allowed(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseRuleNumbers(parameters []string) ([]float64, bool) {
+	numbers := make([]float64, len(parameters))
+	for i, parameter := range parameters {
+		number, err := strconv.ParseFloat(strings.TrimSpace(parameter), 64)
+		if err != nil {
+			return nil, false
+		}
+		numbers[i] = number
+	}
+	return numbers, true
+}
+
+func formatRuleNumber(number float64) string {
+	return strconv.FormatFloat(number, 'f', -1, 64)
+}
+
+// ruleSize - Size of a value as Laravel measures it, the number itself for numeric rules and the length otherwise
+func ruleSize(value string, numeric bool) (float64, bool) {
+	if numeric {
+		number, err := strconv.ParseFloat(value, 64)
+		return number, err == nil
+	}
+	return float64(utf8.RuneCountInString(value)), true
+}
+
+// compilePHPRegex - Converts a delimited PHP pattern such as /^\d+$/i into a Go regular expression
+func compilePHPRegex(pattern string) (*regexp.Regexp, error) {
+	if len(pattern) < 2 {
+		return nil, fmt.Errorf("invalid pattern %q", pattern)
+	}
+
+	delimiter := pattern[0]
+	switch delimiter {
+	case '(':
+		delimiter = ')'
+	case '{':
+		delimiter = '}'
+	case '[':
+		delimiter = ']'
+	case '<':
+		delimiter = '>'
+	}
+
+	end := strings.LastIndexByte(pattern, delimiter)
+	if end <= 0 {
+		return nil, fmt.Errorf("invalid pattern %q", pattern)
+	}
+
+	expression := pattern[1:end]
+	flags := ""
+	for _, modifier := range pattern[end+1:] {
+		switch modifier {
+		case 'i', 'm', 's', 'U':
+			flags += string(modifier)
+		case 'u', 'D':
+		default:
+			return nil, fmt.Errorf("unsupported modifier %q in pattern %q", modifier, pattern)
+		}
+	}
+	if flags != "" {
+		expression = "(?" + flags + ")" + expression
+	}
+
+	return regexp.Compile(expression)
+}
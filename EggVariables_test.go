@@ -0,0 +1,53 @@
+package pterodactyl
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestValidateEnvironment(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules string
+		value interface{}
+		valid bool
+	}{
+		{"boolean true", "required|boolean", true, true},
+		{"boolean false", "required|boolean", false, true},
+		{"boolean string one", "required|boolean", "1", true},
+		{"boolean string zero", "required|boolean", "0", true},
+		{"boolean number", "required|boolean", json.Number("1"), true},
+		{"boolean string true", "required|boolean", "true", false},
+		{"boolean number two", "required|boolean", json.Number("2"), false},
+		{"string", "required|string|max:20", "survival", true},
+		{"string number", "required|string|max:20", json.Number("25565"), false},
+		{"string too long", "required|string|max:5", "survival", false},
+		{"required missing", "required|string", nil, false},
+		{"nullable missing", "nullable|string", nil, true},
+		{"integer string", "required|integer|between:1024,65535", "25565", true},
+		{"integer number", "required|integer|between:1024,65535", json.Number("25565"), true},
+		{"integer out of range", "required|integer|between:1024,65535", "80", false},
+		{"in", "required|string|in:vanilla,paper", "paper", true},
+		{"not in", "required|string|in:vanilla,paper", "forge", false},
+		{"regex", `required|regex:/^([0-9_\.-]{5,10})$/`, "1.20.4", true},
+		{"regex mismatch", `required|regex:/^([0-9_\.-]{5,10})$/`, "latest", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			variables := []EggVariable{{EnvVariable: "VALUE", Rules: test.rules}}
+			environment := Environment{}
+			if test.value != nil {
+				environment["VALUE"] = test.value
+			}
+
+			err := ValidateEnvironment(variables, environment)
+			if test.valid && err != nil {
+				t.Fatalf("expected %v to pass %q: %s", test.value, test.rules, err)
+			}
+			if !test.valid && err == nil {
+				t.Fatalf("expected %v to fail %q", test.value, test.rules)
+			}
+		})
+	}
+}
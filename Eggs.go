@@ -0,0 +1,102 @@
+package pterodactyl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ListEggs - Returns list of eggs in a nest
+func (c *Client) ListEggs(nestID int32, include ...EggInclude) ([]Egg, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/nests/%d/eggs%s", c.HostURL, nestID, includeQuery(include)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var eggList EggsResponse
+	err = json.Unmarshal(body, &eggList)
+	if err != nil {
+		return nil, err
+	}
+
+	eggsData := eggList.Data
+
+	eggs := make([]Egg, len(eggsData))
+	for i, eggData := range eggsData {
+		eggs[i] = eggData.Attributes
+	}
+
+	return eggs, nil
+}
+
+// GetEgg - Returns specific egg of a nest
+func (c *Client) GetEgg(nestID, eggID int32, include ...EggInclude) (Egg, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/nests/%d/eggs/%d%s", c.HostURL, nestID, eggID, includeQuery(include)), nil)
+	if err != nil {
+		return Egg{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Egg{}, err
+	}
+
+	var response EggResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return Egg{}, err
+	}
+
+	egg := response.Attributes
+
+	return egg, nil
+}
+
+// UnmarshalJSON - Decodes an egg configuration, the panel sends an empty array instead of an object for unset sections
+func (e *EggConfig) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Files        json.RawMessage `json:"files"`
+		Startup      json.RawMessage `json:"startup"`
+		Stop         string          `json:"stop"`
+		Logs         json.RawMessage `json:"logs"`
+		FileDenylist []string        `json:"file_denylist"`
+		Extends      interface{}     `json:"extends"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	config := EggConfig{
+		Stop:         raw.Stop,
+		FileDenylist: raw.FileDenylist,
+		Extends:      raw.Extends,
+	}
+	if err := unmarshalEggConfigSection(raw.Files, &config.Files); err != nil {
+		return err
+	}
+	if err := unmarshalEggConfigSection(raw.Startup, &config.Startup); err != nil {
+		return err
+	}
+	if err := unmarshalEggConfigSection(raw.Logs, &config.Logs); err != nil {
+		return err
+	}
+
+	*e = config
+
+	return nil
+}
+
+func unmarshalEggConfigSection(data json.RawMessage, v interface{}) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) || bytes.Equal(trimmed, []byte("[]")) {
+		return nil
+	}
+
+	return json.Unmarshal(trimmed, v)
+}
@@ -0,0 +1,124 @@
+package pterodactyl
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// Environment - Environment variables of a server, keyed by variable name
+type Environment map[string]interface{}
+
+// GetString - Returns the value of a variable as a string
+func (e Environment) GetString(key string) (string, bool) {
+	value, ok := e[key]
+	if !ok {
+		return "", false
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return "", true
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(v), true
+	case int32:
+		return strconv.FormatInt(int64(v), 10), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case bool:
+		if v {
+			return "1", true
+		}
+		return "0", true
+	}
+
+	return "", false
+}
+
+// GetInt - Returns the value of a variable as an integer
+func (e Environment) GetInt(key string) (int64, bool) {
+	value, ok := e[key]
+	if !ok {
+		return 0, false
+	}
+
+	switch v := value.(type) {
+	case string:
+		i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		return i, err == nil
+	case json.Number:
+		i, err := v.Int64()
+		return i, err == nil
+	case float64:
+		return int64(v), v == float64(int64(v))
+	case int:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	}
+
+	return 0, false
+}
+
+// GetBool - Returns the value of a variable as a boolean
+func (e Environment) GetBool(key string) (bool, bool) {
+	value, ok := e[key]
+	if !ok {
+		return false, false
+	}
+
+	switch v := value.(type) {
+	case bool:
+		return v, true
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "1", "true", "on", "yes":
+			return true, true
+		case "0", "false", "off", "no", "":
+			return false, true
+		}
+		return false, false
+	}
+
+	i, ok := e.GetInt(key)
+	return i != 0, ok
+}
+
+// MarshalJSON - Encodes a nil environment as an empty object, as the panel expects
+func (e Environment) MarshalJSON() ([]byte, error) {
+	if e == nil {
+		return []byte("{}"), nil
+	}
+
+	return json.Marshal(map[string]interface{}(e))
+}
+
+// UnmarshalJSON - Decodes an environment, keeping numbers intact and accepting the empty array the panel sends for no variables
+func (e *Environment) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if bytes.Equal(trimmed, []byte("null")) || bytes.Equal(trimmed, []byte("[]")) {
+		*e = Environment{}
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(trimmed))
+	decoder.UseNumber()
+
+	var values map[string]interface{}
+	if err := decoder.Decode(&values); err != nil {
+		return err
+	}
+
+	*e = values
+
+	return nil
+}
@@ -4,39 +4,39 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 // GetLocations - Returns list of locations
 func (c *Client) GetLocations() ([]Location, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/locations", c.HostURL), nil)
-	if err != nil {
-		return nil, err
-	}
+	return c.getLocations(url.Values{})
+}
 
-	body, err := c.doRequest(req, nil)
-	if err != nil {
-		return nil, err
-	}
+func (c *Client) getLocations(query url.Values) ([]Location, error) {
+	locations := make([]Location, 0)
+	err := c.getAllPages("/api/application/locations", query, func(body []byte) (Pagination, error) {
+		var locationList LocationsResponse
+		if err := json.Unmarshal(body, &locationList); err != nil {
+			return Pagination{}, err
+		}
+
+		for _, locationData := range locationList.Data {
+			locations = append(locations, locationData.Attributes)
+		}
 
-	var locationList LocationsResponse
-	err = json.Unmarshal(body, &locationList)
+		return locationList.Meta.Pagination, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	locationsData := locationList.Data
-
-	locations := make([]Location, len(locationsData))
-	for i, locationData := range locationsData {
-		locations[i] = locationData.Attributes
-	}
-
 	return locations, nil
 }
 
 // GetLocation - Returns information about a specific location
-func (c *Client) GetLocation(locationID int32) (Location, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/locations/%d", c.HostURL, locationID), nil)
+func (c *Client) GetLocation(locationID int32, include ...LocationInclude) (Location, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/locations/%d%s", c.HostURL, locationID, includeQuery(include)), nil)
 	if err != nil {
 		return Location{}, err
 	}
@@ -57,6 +57,72 @@ func (c *Client) GetLocation(locationID int32) (Location, error) {
 	return location, nil
 }
 
+// GetLocationByShort - Returns the location with a short code such as "eu-fra"
+func (c *Client) GetLocationByShort(short string) (Location, error) {
+	location, found, err := c.findLocationByShort(short)
+	if err != nil {
+		return Location{}, err
+	}
+	if !found {
+		return Location{}, fmt.Errorf("location with short code %s not found", short)
+	}
+
+	return location, nil
+}
+
+func (c *Client) findLocationByShort(short string) (Location, bool, error) {
+	query := url.Values{}
+	query.Set("filter[short]", short)
+
+	locations, err := c.getLocations(query)
+	if err != nil {
+		return Location{}, false, err
+	}
+
+	// The filter matches partially, so look for the exact short code
+	for _, location := range locations {
+		if location.Short == short {
+			return location, true, nil
+		}
+	}
+
+	return Location{}, false, nil
+}
+
+// Outcomes of an upsert
+const (
+	UpsertCreated   = "created"
+	UpsertUpdated   = "updated"
+	UpsertUnchanged = "unchanged"
+)
+
+// UpsertLocation - Creates a location or updates the location with the same short code, and reports which it did
+func (c *Client) UpsertLocation(location LocationInterface) (Location, string, error) {
+	existing, found, err := c.findLocationByShort(location.GetShort())
+	if err != nil {
+		return Location{}, "", err
+	}
+
+	if !found {
+		created, err := c.CreateLocation(location)
+		if err != nil {
+			return Location{}, "", err
+		}
+		return created, UpsertCreated, nil
+	}
+
+	if existing.Long == location.GetLong() {
+		return existing, UpsertUnchanged, nil
+	}
+
+	updated, err := c.UpdateLocation(existing.ID, location)
+	if err != nil {
+		return Location{}, "", err
+	}
+
+	return updated, UpsertUpdated, nil
+}
+
 // CreateLocation - Creates a new location
 func (c *Client) CreateLocation(location LocationInterface) (Location, error) {
 	partialLocation := PartialLocation{
@@ -84,13 +150,25 @@ func (c *Client) CreateLocation(location LocationInterface) (Location, error) {
 	return newLocation, nil
 }
 
-// UpdateLocation - Updates a location
+// UpdateLocation - Updates every field of a location
 func (c *Client) UpdateLocation(locationID int32, location LocationInterface) (Location, error) {
-	partialLocation := PartialLocation{
-		Short: location.GetShort(),
-		Long:  location.GetLong(),
+	return c.PatchLocation(locationID, LocationPatch{
+		Short: Ptr(location.GetShort()),
+		Long:  Ptr(location.GetLong()),
+	})
+}
+
+// PatchLocation - Updates only the fields of a location set in the patch
+//
+// The location is fetched first so the fields left nil keep their current values.
+func (c *Client) PatchLocation(locationID int32, patch LocationPatch) (Location, error) {
+	current, err := c.GetLocation(locationID)
+	if err != nil {
+		return Location{}, err
 	}
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/application/locations/%d", c.HostURL, locationID), c.prepareBody(partialLocation))
+	patch = patch.merge(current)
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/application/locations/%d", c.HostURL, locationID), c.prepareBody(patch))
 	if err != nil {
 		return Location{}, err
 	}
@@ -125,3 +203,59 @@ func (c *Client) DeleteLocation(locationID int32) error {
 
 	return nil
 }
+
+// LocationInUseError - Returned when a location cannot be deleted because nodes are still attached to it
+type LocationInUseError struct {
+	LocationID int32
+	Nodes      []Node
+}
+
+func (e *LocationInUseError) Error() string {
+	names := make([]string, len(e.Nodes))
+	for i, node := range e.Nodes {
+		names[i] = fmt.Sprintf("%s (%d)", node.Name, node.ID)
+	}
+
+	return fmt.Sprintf("location %d still has nodes attached: %s", e.LocationID, strings.Join(names, ", "))
+}
+
+// SafeDeleteLocation - Deletes a location after checking that no nodes are attached to it
+//
+// When reassignTo is set, attached nodes are moved to that location first, otherwise a *LocationInUseError is returned.
+// The nodes moved are returned, also when a later step fails, so a partial cascade can be undone.
+func (c *Client) SafeDeleteLocation(locationID int32, reassignTo int32) ([]Node, error) {
+	if reassignTo == locationID {
+		return nil, fmt.Errorf("cannot reassign the nodes of location %d to itself", locationID)
+	}
+
+	location, err := c.GetLocation(locationID, LocationIncludeNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []Node
+	for _, nodeData := range location.Relationships.Nodes.Data {
+		nodes = append(nodes, nodeData.Attributes)
+	}
+
+	var reassigned []Node
+	if len(nodes) > 0 {
+		if reassignTo == 0 {
+			return nil, &LocationInUseError{LocationID: locationID, Nodes: nodes}
+		}
+
+		if _, err := c.GetLocation(reassignTo); err != nil {
+			return nil, err
+		}
+
+		for _, node := range nodes {
+			moved, err := c.PatchNode(node.ID, NodePatch{LocationID: Ptr(reassignTo)})
+			if err != nil {
+				return reassigned, fmt.Errorf("reassigning node %d to location %d: %w", node.ID, reassignTo, err)
+			}
+			reassigned = append(reassigned, moved)
+		}
+	}
+
+	return reassigned, c.DeleteLocation(locationID)
+}
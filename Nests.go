@@ -0,0 +1,52 @@
+package pterodactyl
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ListNests - Returns list of nests
+func (c *Client) ListNests() ([]Nest, error) {
+	nests := make([]Nest, 0)
+	err := c.getAllPages("/api/application/nests", nil, func(body []byte) (Pagination, error) {
+		var nestList NestsResponse
+		if err := json.Unmarshal(body, &nestList); err != nil {
+			return Pagination{}, err
+		}
+
+		for _, nestData := range nestList.Data {
+			nests = append(nests, nestData.Attributes)
+		}
+
+		return nestList.Meta.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nests, nil
+}
+
+// GetNest - Returns specific nest
+func (c *Client) GetNest(nestID int32) (Nest, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/nests/%d", c.HostURL, nestID), nil)
+	if err != nil {
+		return Nest{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Nest{}, err
+	}
+
+	var response NestResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return Nest{}, err
+	}
+
+	nest := response.Attributes
+
+	return nest, nil
+}
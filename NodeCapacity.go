@@ -0,0 +1,164 @@
+package pterodactyl
+
+import "sort"
+
+// ResourceCapacity - Capacity and commitment of a node resource in megabytes
+type ResourceCapacity struct {
+	// Total - Amount configured on the node
+	Total int64
+	// Limit - Amount that may be committed including overallocation, zero when Unlimited
+	Limit int64
+	// Unlimited - Overallocation is disabled (-1), so commitment is not capped
+	Unlimited bool
+	// Committed - Sum of the limits of every server on the node
+	Committed int64
+	// Headroom - Amount left before reaching Limit, negative when overcommitted and zero when Unlimited
+	Headroom int64
+	// PercentUsed - Committed as a percentage of Limit, or of Total when Unlimited
+	PercentUsed float64
+}
+
+func (r ResourceCapacity) overcommitted() bool {
+	return !r.Unlimited && r.Committed > r.Limit
+}
+
+func (r *ResourceCapacity) add(other ResourceCapacity) {
+	r.Total += other.Total
+	r.Limit += other.Limit
+	r.Unlimited = r.Unlimited || other.Unlimited
+	r.Committed += other.Committed
+	r.compute()
+}
+
+func (r *ResourceCapacity) compute() {
+	r.Headroom = 0
+	base := r.Total
+	if !r.Unlimited {
+		r.Headroom = r.Limit - r.Committed
+		base = r.Limit
+	}
+
+	r.PercentUsed = 0
+	if base > 0 {
+		r.PercentUsed = float64(r.Committed) / float64(base) * 100
+	}
+}
+
+func newResourceCapacity(total, overallocate int32, committed int64) ResourceCapacity {
+	limit, limited := overallocatedLimit(total, overallocate)
+	capacity := ResourceCapacity{
+		Total:     int64(total),
+		Limit:     limit,
+		Unlimited: !limited,
+		Committed: committed,
+	}
+	capacity.compute()
+
+	return capacity
+}
+
+// NodeCapacity - Capacity of a node and the resources committed to its servers
+type NodeCapacity struct {
+	Node          Node
+	Servers       int
+	Memory        ResourceCapacity
+	Disk          ResourceCapacity
+	Overcommitted bool
+}
+
+// LocationCapacity - Capacity of the nodes of a location combined
+type LocationCapacity struct {
+	LocationID    int32
+	Nodes         []NodeCapacity
+	Servers       int
+	Memory        ResourceCapacity
+	Disk          ResourceCapacity
+	Overcommitted bool
+}
+
+// CapacityReport -
+type CapacityReport struct {
+	Nodes     []NodeCapacity
+	Locations []LocationCapacity
+}
+
+// OvercommittedNodes - Returns the nodes whose servers are allowed more memory or disk than the node can give
+func (r CapacityReport) OvercommittedNodes() []NodeCapacity {
+	var nodes []NodeCapacity
+	for _, node := range r.Nodes {
+		if node.Overcommitted {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// BuildCapacityReport - Sums the limits of the servers of every node and applies the overallocation of the node
+func BuildCapacityReport(nodes []Node, servers []Server) CapacityReport {
+	type commitment struct {
+		servers      int
+		memory, disk int64
+	}
+	committed := map[int32]*commitment{}
+	for _, server := range servers {
+		if committed[server.Node] == nil {
+			committed[server.Node] = &commitment{}
+		}
+		committed[server.Node].servers++
+		committed[server.Node].memory += int64(server.Limits.Memory)
+		committed[server.Node].disk += int64(server.Limits.Disk)
+	}
+
+	var report CapacityReport
+	locations := map[int32]*LocationCapacity{}
+	for _, node := range nodes {
+		used := committed[node.ID]
+		if used == nil {
+			used = &commitment{}
+		}
+
+		capacity := NodeCapacity{
+			Node:    node,
+			Servers: used.servers,
+			Memory:  newResourceCapacity(node.Memory, node.MemoryOverallocate, used.memory),
+			Disk:    newResourceCapacity(node.Disk, node.DiskOverallocate, used.disk),
+		}
+		capacity.Overcommitted = capacity.Memory.overcommitted() || capacity.Disk.overcommitted()
+		report.Nodes = append(report.Nodes, capacity)
+
+		location := locations[node.LocationID]
+		if location == nil {
+			location = &LocationCapacity{LocationID: node.LocationID}
+			locations[node.LocationID] = location
+		}
+		location.Nodes = append(location.Nodes, capacity)
+		location.Servers += capacity.Servers
+		location.Memory.add(capacity.Memory)
+		location.Disk.add(capacity.Disk)
+		location.Overcommitted = location.Overcommitted || capacity.Overcommitted
+	}
+
+	for _, location := range locations {
+		report.Locations = append(report.Locations, *location)
+	}
+	sort.Slice(report.Locations, func(i, j int) bool {
+		return report.Locations[i].LocationID < report.Locations[j].LocationID
+	})
+
+	return report
+}
+
+// GetCapacityReport - Returns the capacity of every node and location of the panel
+func (c *Client) GetCapacityReport() (CapacityReport, error) {
+	nodes, err := c.GetNodes()
+	if err != nil {
+		return CapacityReport{}, err
+	}
+
+	servers, err := c.GetServers()
+	if err != nil {
+		return CapacityReport{}, err
+	}
+
+	return BuildCapacityReport(nodes, servers), nil
+}
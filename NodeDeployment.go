@@ -0,0 +1,73 @@
+package pterodactyl
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// DeployableNodesRequest - Resources a new server needs, used to find nodes that can host it
+type DeployableNodesRequest struct {
+	Memory      int32
+	Disk        int32
+	LocationIDs []int32
+}
+
+// GetDeployableNodes - Returns the nodes with enough memory and disk left, including overallocation, to host a server
+func (c *Client) GetDeployableNodes(request DeployableNodesRequest) ([]Node, error) {
+	query := url.Values{}
+	query.Set("memory", strconv.FormatInt(int64(request.Memory), 10))
+	query.Set("disk", strconv.FormatInt(int64(request.Disk), 10))
+	for _, locationID := range request.LocationIDs {
+		query.Add("location_ids[]", strconv.FormatInt(int64(locationID), 10))
+	}
+
+	nodes := make([]Node, 0)
+	err := c.getAllPages("/api/application/nodes/deployable", query, func(body []byte) (Pagination, error) {
+		var nodeList NodesResponse
+		if err := json.Unmarshal(body, &nodeList); err != nil {
+			return Pagination{}, err
+		}
+
+		for _, nodeData := range nodeList.Data {
+			nodes = append(nodes, nodeData.Attributes)
+		}
+
+		return nodeList.Meta.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+// overallocatedLimit - Amount including an overallocation percentage, false when the overallocation is unlimited (-1)
+func overallocatedLimit(amount, overallocate int32) (int64, bool) {
+	if overallocate < 0 {
+		return 0, false
+	}
+	return int64(amount) * (100 + int64(overallocate)) / 100, true
+}
+
+// MemoryLimit - Returns the memory that may be assigned to servers on the node including overallocation, false when unlimited
+func (n Node) MemoryLimit() (int64, bool) {
+	return overallocatedLimit(n.Memory, n.MemoryOverallocate)
+}
+
+// DiskLimit - Returns the disk that may be assigned to servers on the node including overallocation, false when unlimited
+func (n Node) DiskLimit() (int64, bool) {
+	return overallocatedLimit(n.Disk, n.DiskOverallocate)
+}
+
+// FreeMemory - Returns the memory still assignable on the node, false when unlimited
+func (n Node) FreeMemory() (int64, bool) {
+	limit, ok := n.MemoryLimit()
+	return limit - n.AllocatedResources.Memory, ok
+}
+
+// FreeDisk - Returns the disk still assignable on the node, false when unlimited
+func (n Node) FreeDisk() (int64, bool) {
+	limit, ok := n.DiskLimit()
+	return limit - n.AllocatedResources.Disk, ok
+}
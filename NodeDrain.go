@@ -0,0 +1,168 @@
+package pterodactyl
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DrainOptions - Options for DrainNode
+type DrainOptions struct {
+	// Warning - Console command sent to every running server first, such as "say Node going down for maintenance"
+	Warning string
+	// WarningDelay - Time between the warning and stopping the servers
+	WarningDelay time.Duration
+	// Stop - Gracefully stop the running servers and wait for them to go offline
+	Stop bool
+	// StopTimeout - How long to wait for the servers to stop (defaults to 5 minutes)
+	StopTimeout time.Duration
+	// PollInterval - Delay between checks of the power state (defaults to 5 seconds)
+	PollInterval time.Duration
+}
+
+// DrainedServer - Outcome of draining a single server
+type DrainedServer struct {
+	Server  Server
+	Warned  bool
+	Stopped bool
+	// State - Last power state reported for the server
+	State string
+	// WarnErr - Why the warning could not be sent, kept apart from Err as the server may still be stopped
+	WarnErr error
+	Err     error
+}
+
+// DrainReport - Outcome of DrainNode
+type DrainReport struct {
+	Node    Node
+	Servers []DrainedServer
+}
+
+// Failed - Returns the servers that could not be warned or stopped
+func (r DrainReport) Failed() []DrainedServer {
+	var failed []DrainedServer
+	for _, server := range r.Servers {
+		if server.Err != nil || server.WarnErr != nil {
+			failed = append(failed, server)
+		}
+	}
+	return failed
+}
+
+// DrainNode - Puts a node into maintenance mode, then optionally warns and stops every server hosted on it
+//
+// Console and power requests go through the client API, so ClientToken must hold a client API key of an administrator.
+// Failures of individual servers are recorded in the report instead of aborting the drain.
+func (c *Client) DrainNode(ctx context.Context, nodeID int32, opts DrainOptions) (DrainReport, error) {
+	stopTimeout := opts.StopTimeout
+	if stopTimeout <= 0 {
+		stopTimeout = 5 * time.Minute
+	}
+	pollInterval := opts.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = 5 * time.Second
+	}
+
+	if _, err := c.PatchNode(nodeID, NodePatch{MaintenanceMode: Ptr(true)}); err != nil {
+		return DrainReport{}, err
+	}
+
+	node, err := c.GetNode(nodeID, NodeIncludeServers)
+	if err != nil {
+		return DrainReport{}, err
+	}
+
+	report := DrainReport{Node: node}
+	for _, serverData := range node.Relationships.Servers.Data {
+		report.Servers = append(report.Servers, DrainedServer{Server: serverData.Attributes})
+	}
+	if opts.Warning == "" && !opts.Stop {
+		return report, nil
+	}
+
+	var running []*DrainedServer
+	for i := range report.Servers {
+		drained := &report.Servers[i]
+
+		// A server whose state cannot be read is not warned but is still stopped
+		resources, err := c.GetServerResources(drained.Server.Identifier)
+		if err == nil {
+			drained.State = resources.CurrentState
+			if resources.CurrentState == PowerStateOffline {
+				drained.Stopped = true
+				continue
+			}
+		} else if !opts.Stop {
+			drained.Err = err
+			continue
+		}
+		running = append(running, drained)
+
+		if err == nil && opts.Warning != "" {
+			if err := c.SendServerCommand(drained.Server.Identifier, opts.Warning); err != nil {
+				drained.WarnErr = err
+			} else {
+				drained.Warned = true
+			}
+		}
+	}
+	if !opts.Stop || len(running) == 0 {
+		return report, nil
+	}
+
+	if opts.Warning != "" && opts.WarningDelay > 0 {
+		if err := sleepContext(ctx, opts.WarningDelay); err != nil {
+			return report, err
+		}
+	}
+
+	var stopping []*DrainedServer
+	for _, drained := range running {
+		if err := c.SetServerPowerState(drained.Server.Identifier, PowerSignalStop); err != nil {
+			drained.Err = err
+			continue
+		}
+		stopping = append(stopping, drained)
+	}
+
+	deadline := time.Now().Add(stopTimeout)
+	for {
+		pending := 0
+		for _, drained := range stopping {
+			if drained.Stopped {
+				continue
+			}
+
+			// Errors while polling are retried until the deadline
+			resources, err := c.GetServerResources(drained.Server.Identifier)
+			if err == nil {
+				drained.State = resources.CurrentState
+				drained.Stopped = resources.CurrentState == PowerStateOffline
+			}
+			if !drained.Stopped {
+				pending++
+			}
+		}
+		if pending == 0 {
+			return report, nil
+		}
+
+		if time.Now().After(deadline) {
+			for _, drained := range stopping {
+				if !drained.Stopped {
+					drained.Err = fmt.Errorf("server %s did not stop within %s", drained.Server.Identifier, stopTimeout)
+				}
+			}
+			return report, nil
+		}
+
+		if err := sleepContext(ctx, pollInterval); err != nil {
+			return report, err
+		}
+	}
+}
+
+// UndrainNode - Takes a node out of maintenance mode
+func (c *Client) UndrainNode(nodeID int32) (Node, error) {
+	return c.PatchNode(nodeID, NodePatch{MaintenanceMode: Ptr(false)})
+}
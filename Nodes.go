@@ -3,40 +3,36 @@ package pterodactyl
 import (
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
 )
 
 // GetNodes - Returns list of nodes
 func (c *Client) GetNodes() ([]Node, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/nodes", c.HostURL), nil)
+	nodes := make([]Node, 0)
+	err := c.getAllPages("/api/application/nodes", nil, func(body []byte) (Pagination, error) {
+		var nodeList NodesResponse
+		if err := json.Unmarshal(body, &nodeList); err != nil {
+			return Pagination{}, err
+		}
+
+		for _, nodeData := range nodeList.Data {
+			nodes = append(nodes, nodeData.Attributes)
+		}
+
+		return nodeList.Meta.Pagination, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var nodeList NodesResponse
-	err = json.Unmarshal(body, &nodeList)
-	if err != nil {
-		return nil, err
-	}
-
-	nodesData := nodeList.Data
-
-	nodes := make([]Node, len(nodesData))
-	for i, nodeData := range nodesData {
-		nodes[i] = nodeData.Attributes
-	}
-
 	return nodes, nil
 }
 
 // GetNode - Returns specific node
-func (c *Client) GetNode(nodeID int32) (Node, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/nodes/%d", c.HostURL, nodeID), nil)
+func (c *Client) GetNode(nodeID int32, include ...NodeInclude) (Node, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/nodes/%d%s", c.HostURL, nodeID, includeQuery(include)), nil)
 	if err != nil {
 		return Node{}, err
 	}
@@ -69,13 +65,21 @@ func (c *Client) GetNodeConfiguration(nodeID int32) (NodeConfiguration, error) {
 		return NodeConfiguration{}, err
 	}
 
+	// The panel returns the configuration itself rather than an object/attributes wrapper
 	var response NodeConfigurationResponse
 	err = json.Unmarshal(body, &response)
 	if err != nil {
 		return NodeConfiguration{}, err
 	}
+	if response.Object != "" {
+		return response.Attributes, nil
+	}
 
-	nodeConfiguration := response.Attributes
+	var nodeConfiguration NodeConfiguration
+	err = json.Unmarshal(body, &nodeConfiguration)
+	if err != nil {
+		return NodeConfiguration{}, err
+	}
 
 	return nodeConfiguration, nil
 }
@@ -122,28 +126,40 @@ func (c *Client) CreateNode(node NodesInterface) (Node, error) {
 	return newNode, nil
 }
 
-// UpdateNode - Updates a node
+// UpdateNode - Updates every field of a node
 func (c *Client) UpdateNode(nodeID int32, node NodesInterface) (Node, error) {
-	updatedNode := PartialNode{
-		Name:               node.GetName(),
-		Description:        node.GetDescription(),
-		ContainerText:      node.GetContainerText(),
-		Public:             node.GetPublic(),
-		BehindProxy:        node.GetBehindProxy(),
-		MaintenanceMode:    node.GetMaintenanceMode(),
-		LocationID:         node.GetLocationID(),
-		FQDN:               node.GetFQDN(),
-		Scheme:             node.GetScheme(),
-		Memory:             node.GetMemory(),
-		MemoryOverallocate: node.GetMemoryOverallocate(),
-		Disk:               node.GetDisk(),
-		DiskOverallocate:   node.GetDiskOverallocate(),
-		UploadSize:         node.GetUploadSize(),
-		DaemonListen:       node.GetDaemonListen(),
-		DaemonText:         node.GetDaemonText(),
-		DaemonSFTP:         node.GetDaemonSFTP(),
+	return c.PatchNode(nodeID, NodePatch{
+		Name:               Ptr(node.GetName()),
+		Description:        Ptr(node.GetDescription()),
+		ContainerText:      Ptr(node.GetContainerText()),
+		Public:             Ptr(node.GetPublic()),
+		BehindProxy:        Ptr(node.GetBehindProxy()),
+		MaintenanceMode:    Ptr(node.GetMaintenanceMode()),
+		LocationID:         Ptr(node.GetLocationID()),
+		FQDN:               Ptr(node.GetFQDN()),
+		Scheme:             Ptr(node.GetScheme()),
+		Memory:             Ptr(node.GetMemory()),
+		MemoryOverallocate: Ptr(node.GetMemoryOverallocate()),
+		Disk:               Ptr(node.GetDisk()),
+		DiskOverallocate:   Ptr(node.GetDiskOverallocate()),
+		UploadSize:         Ptr(node.GetUploadSize()),
+		DaemonListen:       Ptr(node.GetDaemonListen()),
+		DaemonText:         Ptr(node.GetDaemonText()),
+		DaemonSFTP:         Ptr(node.GetDaemonSFTP()),
+	})
+}
+
+// PatchNode - Updates only the fields of a node set in the patch
+//
+// The panel requires every field on update, so the node is fetched and the fields left nil keep their current values.
+func (c *Client) PatchNode(nodeID int32, patch NodePatch) (Node, error) {
+	current, err := c.GetNode(nodeID)
+	if err != nil {
+		return Node{}, err
 	}
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/application/nodes/%d", c.HostURL, nodeID), c.prepareBody(updatedNode))
+	patch = patch.merge(current)
+
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/application/nodes/%d", c.HostURL, nodeID), c.prepareBody(patch))
 	if err != nil {
 		return Node{}, err
 	}
@@ -181,45 +197,91 @@ func (c *Client) DeleteNode(nodeID int32) error {
 
 // GetNodeAllocations - Returns list of allocations added to a node
 func (c *Client) GetNodeAllocations(nodeID int32) ([]Allocation, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/nodes/%d/allocations", c.HostURL, nodeID), nil)
+	return c.getNodeAllocations(nodeID, url.Values{})
+}
+
+func (c *Client) getNodeAllocations(nodeID int32, query url.Values) ([]Allocation, error) {
+	query.Set("per_page", "500")
+
+	allocations := make([]Allocation, 0)
+	err := c.getAllPages(fmt.Sprintf("/api/application/nodes/%d/allocations", nodeID), query, func(body []byte) (Pagination, error) {
+		var allocationList AllocationsResponse
+		if err := json.Unmarshal(body, &allocationList); err != nil {
+			return Pagination{}, err
+		}
+
+		for _, allocationData := range allocationList.Data {
+			allocations = append(allocations, allocationData.Attributes)
+		}
+
+		return allocationList.Meta.Pagination, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req, nil)
+	return allocations, nil
+}
+
+// CreateAllocation - Adds allocations to a node and returns every allocation that now exists for the IP
+//
+// Ports are validated first and ranges larger than the panel accepts are split into smaller ranges.
+func (c *Client) CreateAllocation(nodeID int32, allocation PartialAllocation) ([]Allocation, error) {
+	ranges, err := ParsePortRanges(allocation.Ports)
 	if err != nil {
 		return nil, err
 	}
 
-	var allocationList AllocationsResponse
-	err = json.Unmarshal(body, &allocationList)
+	request := PartialAllocation{IP: allocation.IP, Alias: allocation.Alias}
+	for _, portRange := range ranges {
+		request.AddPorts(portRange.Chunks()...)
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/application/nodes/%d/allocations", c.HostURL, nodeID), c.prepareBody(request))
 	if err != nil {
 		return nil, err
 	}
 
-	allocationsData := allocationList.Data
+	_, err = c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	allocations, err := c.GetNodeAllocations(nodeID)
+	if err != nil {
+		return nil, err
+	}
 
-	allocations := make([]Allocation, len(allocationsData))
-	for i, allocationData := range allocationsData {
-		allocations[i] = allocationData.Attributes
+	created := make([]Allocation, 0)
+	for _, existing := range allocations {
+		if allocationMatchesIP(existing, allocation.IP) {
+			created = append(created, existing)
+		}
 	}
 
-	return allocations, nil
+	return created, nil
 }
 
-// CreateAllocation - Adds an allocation to a node
-func (c *Client) CreateAllocation(nodeID int32, allocation PartialAllocation) error {
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/application/nodes/%d/allocations", c.HostURL, nodeID), c.prepareBody(allocation))
-	if err != nil {
-		return err
+// CreateAllocationRange - Adds allocations for ranges of ports on an IP to a node
+func (c *Client) CreateAllocationRange(nodeID int32, ip string, ranges ...PortRange) ([]Allocation, error) {
+	allocation := PartialAllocation{IP: ip}
+	allocation.AddPorts(ranges...)
+
+	return c.CreateAllocation(nodeID, allocation)
+}
+
+// allocationMatchesIP - Reports whether an allocation belongs to an IP, which may be a CIDR block
+func allocationMatchesIP(allocation Allocation, ip string) bool {
+	if allocation.IP == ip {
+		return true
 	}
 
-	_, err = c.doRequest(req, nil)
+	_, network, err := net.ParseCIDR(ip)
 	if err != nil {
-		return err
+		return false
 	}
 
-	return nil
+	return network.Contains(net.ParseIP(allocation.IP))
 }
 
 // DeleteAllocation - Deletes an allocation from a node
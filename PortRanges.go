@@ -0,0 +1,109 @@
+package pterodactyl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Port limits the panel enforces when creating allocations
+const (
+	// PortFloor - Allocated ports must be above this port
+	PortFloor = 1024
+	// PortCeil - Highest port that can be allocated
+	PortCeil = 65535
+	// PortRangeLimit - Largest number of ports the panel accepts in a single range
+	PortRangeLimit = 1000
+)
+
+// PortRange - Inclusive range of ports, a single port has the same start and end
+type PortRange struct {
+	Start int32
+	End   int32
+}
+
+// ParsePortRange - Parses a single port ("25565") or a range of ports ("25565-25600")
+func ParsePortRange(s string) (PortRange, error) {
+	start, end, isRange := strings.Cut(strings.TrimSpace(s), "-")
+
+	first, err := strconv.ParseInt(strings.TrimSpace(start), 10, 32)
+	if err != nil {
+		return PortRange{}, fmt.Errorf("invalid port %q", s)
+	}
+	portRange := PortRange{Start: int32(first), End: int32(first)}
+
+	if isRange {
+		last, err := strconv.ParseInt(strings.TrimSpace(end), 10, 32)
+		if err != nil {
+			return PortRange{}, fmt.Errorf("invalid port range %q", s)
+		}
+		portRange.End = int32(last)
+	}
+
+	return portRange, portRange.Validate()
+}
+
+// ParsePortRanges - Parses a list of ports and port ranges
+func ParsePortRanges(ports []string) ([]PortRange, error) {
+	ranges := make([]PortRange, len(ports))
+	for i, port := range ports {
+		portRange, err := ParsePortRange(port)
+		if err != nil {
+			return nil, err
+		}
+		ranges[i] = portRange
+	}
+
+	return ranges, nil
+}
+
+// Validate - Checks that the range is ordered and within the ports the panel can allocate
+func (r PortRange) Validate() error {
+	if r.Start > r.End {
+		return fmt.Errorf("port range %s ends before it starts", r)
+	}
+	if r.Start <= PortFloor || r.End > PortCeil {
+		return fmt.Errorf("port range %s must be between %d and %d", r, PortFloor+1, PortCeil)
+	}
+	return nil
+}
+
+// Size - Returns the number of ports in the range
+func (r PortRange) Size() int {
+	return int(r.End-r.Start) + 1
+}
+
+// Contains - Reports whether a port is part of the range
+func (r PortRange) Contains(port int32) bool {
+	return port >= r.Start && port <= r.End
+}
+
+// Chunks - Splits the range into ranges the panel accepts in a single request
+func (r PortRange) Chunks() []PortRange {
+	var chunks []PortRange
+	for start := r.Start; start <= r.End; start += PortRangeLimit {
+		end := start + PortRangeLimit - 1
+		if end > r.End {
+			end = r.End
+		}
+		chunks = append(chunks, PortRange{Start: start, End: end})
+		if end == r.End {
+			break
+		}
+	}
+	return chunks
+}
+
+func (r PortRange) String() string {
+	if r.Start == r.End {
+		return strconv.FormatInt(int64(r.Start), 10)
+	}
+	return fmt.Sprintf("%d-%d", r.Start, r.End)
+}
+
+// AddPorts - Adds ports to an allocation request
+func (p *PartialAllocation) AddPorts(ranges ...PortRange) {
+	for _, portRange := range ranges {
+		p.Ports = append(p.Ports, portRange.String())
+	}
+}
@@ -0,0 +1,77 @@
+package pterodactyl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePortRange(t *testing.T) {
+	tests := []struct {
+		input string
+		want  PortRange
+		valid bool
+	}{
+		{"25565", PortRange{Start: 25565, End: 25565}, true},
+		{" 25565 - 25600 ", PortRange{Start: 25565, End: 25600}, true},
+		{"1025", PortRange{Start: 1025, End: 1025}, true},
+		{"65535", PortRange{Start: 65535, End: 65535}, true},
+		{"1024", PortRange{}, false},
+		{"80", PortRange{}, false},
+		{"65536", PortRange{}, false},
+		{"25600-25565", PortRange{}, false},
+		{"port", PortRange{}, false},
+		{"25565-", PortRange{}, false},
+	}
+
+	for _, test := range tests {
+		got, err := ParsePortRange(test.input)
+		if test.valid != (err == nil) {
+			t.Fatalf("%q: got error %v, want valid %t", test.input, err, test.valid)
+		}
+		if test.valid && got != test.want {
+			t.Fatalf("%q: got %+v, want %+v", test.input, got, test.want)
+		}
+	}
+}
+
+func TestPortRangeChunks(t *testing.T) {
+	tests := []struct {
+		portRange PortRange
+		want      []PortRange
+	}{
+		{PortRange{Start: 25565, End: 25565}, []PortRange{{Start: 25565, End: 25565}}},
+		{PortRange{Start: 2000, End: 2999}, []PortRange{{Start: 2000, End: 2999}}},
+		{PortRange{Start: 2000, End: 3000}, []PortRange{{Start: 2000, End: 2999}, {Start: 3000, End: 3000}}},
+		{PortRange{Start: 2000, End: 4499}, []PortRange{{Start: 2000, End: 2999}, {Start: 3000, End: 3999}, {Start: 4000, End: 4499}}},
+		{PortRange{Start: 64535, End: 65535}, []PortRange{{Start: 64535, End: 65534}, {Start: 65535, End: 65535}}},
+	}
+
+	for _, test := range tests {
+		got := test.portRange.Chunks()
+		if !reflect.DeepEqual(got, test.want) {
+			t.Fatalf("%s: got %v, want %v", test.portRange, got, test.want)
+		}
+
+		size := 0
+		for _, chunk := range got {
+			if chunk.Size() > PortRangeLimit {
+				t.Fatalf("%s: chunk %s exceeds %d ports", test.portRange, chunk, PortRangeLimit)
+			}
+			size += chunk.Size()
+		}
+		if size != test.portRange.Size() {
+			t.Fatalf("%s: chunks cover %d ports, want %d", test.portRange, size, test.portRange.Size())
+		}
+	}
+}
+
+func TestPortRangeString(t *testing.T) {
+	for input, want := range map[PortRange]string{
+		{Start: 25565, End: 25565}: "25565",
+		{Start: 25565, End: 25600}: "25565-25600",
+	} {
+		if got := input.String(); got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package pterodactyl
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ListServerDatabases - Returns list of databases of a server
+func (c *Client) ListServerDatabases(serverID int32) ([]ServerDatabase, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/servers/%d/databases", c.HostURL, serverID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var databaseList ServerDatabasesResponse
+	err = json.Unmarshal(body, &databaseList)
+	if err != nil {
+		return nil, err
+	}
+
+	databasesData := databaseList.Data
+
+	databases := make([]ServerDatabase, len(databasesData))
+	for i, databaseData := range databasesData {
+		databases[i] = databaseData.Attributes
+	}
+
+	return databases, nil
+}
+
+// GetServerDatabase - Returns specific database of a server, including its password and host
+func (c *Client) GetServerDatabase(serverID, databaseID int32) (ServerDatabase, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/servers/%d/databases/%d?include=password,host", c.HostURL, serverID, databaseID), nil)
+	if err != nil {
+		return ServerDatabase{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ServerDatabase{}, err
+	}
+
+	var response ServerDatabaseResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return ServerDatabase{}, err
+	}
+
+	database := response.Attributes
+
+	return database, nil
+}
+
+// CreateServerDatabase - Creates a new database for a server
+func (c *Client) CreateServerDatabase(serverID int32, database PartialServerDatabase) (ServerDatabase, error) {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/application/servers/%d/databases", c.HostURL, serverID), c.prepareBody(database))
+	if err != nil {
+		return ServerDatabase{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ServerDatabase{}, err
+	}
+
+	var response ServerDatabaseResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return ServerDatabase{}, err
+	}
+
+	newDatabase := response.Attributes
+
+	return newDatabase, nil
+}
+
+// ResetServerDatabasePassword - Generates a new password for a server database
+func (c *Client) ResetServerDatabasePassword(serverID, databaseID int32) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/application/servers/%d/databases/%d/reset-password", c.HostURL, serverID, databaseID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteServerDatabase - Deletes a database from a server
+func (c *Client) DeleteServerDatabase(serverID, databaseID int32) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/application/servers/%d/databases/%d", c.HostURL, serverID, databaseID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
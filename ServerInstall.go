@@ -0,0 +1,132 @@
+package pterodactyl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WaitForServerInstalledOptions - Options for WaitForServerInstalled
+type WaitForServerInstalledOptions struct {
+	// Interval - Delay between the first polls, doubled after every poll (defaults to 2 seconds)
+	Interval time.Duration
+	// MaxInterval - Upper bound for the delay between polls (defaults to 30 seconds)
+	MaxInterval time.Duration
+	// OnProgress - Called after every poll of the server
+	OnProgress func(ServerInstallProgress)
+}
+
+// ServerInstallProgress - State of a server installation after a poll
+type ServerInstallProgress struct {
+	Attempt   int
+	Elapsed   time.Duration
+	Status    string
+	Installed bool
+	Server    Server
+	// Err - Error of a failed poll, which is retried on the same schedule
+	Err error
+}
+
+// ServerInstallError - Returned when the install script of a server failed
+type ServerInstallError struct {
+	ServerID int32
+	Status   string
+}
+
+func (e *ServerInstallError) Error() string {
+	return fmt.Sprintf("server %d failed to install: %s", e.ServerID, e.Status)
+}
+
+// WaitForServerInstalled - Polls a server until its installation has finished and returns the installed server
+//
+// Network errors, 5xx and 429 responses are retried on the same schedule. Other errors, such as a deleted server
+// or a rejected key, end the wait at once. When the context is done the last poll error is wrapped into its error.
+func (c *Client) WaitForServerInstalled(ctx context.Context, serverID int32, opts WaitForServerInstalledOptions) (Server, error) {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	maxInterval := opts.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = 30 * time.Second
+	}
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+
+	var server Server
+	var lastErr error
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		polled, err := c.getServer(ctx, serverID)
+		if ctx.Err() != nil {
+			return server, waitError(ctx.Err(), lastErr)
+		}
+		if err == nil {
+			server = polled
+		}
+		lastErr = err
+
+		installed := err == nil && bool(server.Container.Installed) && server.Status != ServerStatusInstalling
+		if opts.OnProgress != nil {
+			opts.OnProgress(ServerInstallProgress{
+				Attempt:   attempt,
+				Elapsed:   time.Since(start),
+				Status:    server.Status,
+				Installed: installed,
+				Server:    server,
+				Err:       err,
+			})
+		}
+
+		if err != nil && !retryablePollError(err) {
+			return server, err
+		}
+		if err == nil {
+			switch server.Status {
+			case ServerStatusInstallFailed, ServerStatusReinstallFailed:
+				return server, &ServerInstallError{ServerID: serverID, Status: server.Status}
+			}
+			if installed {
+				return server, nil
+			}
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return server, waitError(err, lastErr)
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// retryablePollError - Reports whether a failed poll may succeed when retried
+func retryablePollError(err error) bool {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= 500 || statusErr.StatusCode == http.StatusTooManyRequests
+	}
+
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &syntaxErr) || errors.As(err, &typeErr) {
+		return false
+	}
+
+	// Anything else failed before a response was read
+	return true
+}
+
+// waitError - Adds the error of the last poll to the error that ended a wait
+func waitError(err, lastErr error) error {
+	if lastErr == nil {
+		return err
+	}
+	return fmt.Errorf("%w, last poll error: %v", err, lastErr)
+}
@@ -0,0 +1,176 @@
+package pterodactyl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetServers - Returns list of servers
+func (c *Client) GetServers() ([]Server, error) {
+	servers := make([]Server, 0)
+	err := c.getAllPages("/api/application/servers", nil, func(body []byte) (Pagination, error) {
+		var serverList ServersResponse
+		if err := json.Unmarshal(body, &serverList); err != nil {
+			return Pagination{}, err
+		}
+
+		for _, serverData := range serverList.Data {
+			servers = append(servers, serverData.Attributes)
+		}
+
+		return serverList.Meta.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return servers, nil
+}
+
+// GetServer - Returns specific server
+func (c *Client) GetServer(serverID int32, include ...ServerInclude) (Server, error) {
+	return c.getServer(context.Background(), serverID, include...)
+}
+
+func (c *Client) getServer(ctx context.Context, serverID int32, include ...ServerInclude) (Server, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/application/servers/%d%s", c.HostURL, serverID, includeQuery(include)), nil)
+	if err != nil {
+		return Server{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Server{}, err
+	}
+
+	var response ServerResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return Server{}, err
+	}
+
+	server := response.Attributes
+
+	return server, nil
+}
+
+// CreateServer - Creates a new server, rejecting an invalid environment first when the egg variables are given
+func (c *Client) CreateServer(server PartialServer) (Server, error) {
+	if server.Variables != nil {
+		if err := ValidateEnvironment(server.Variables, server.Environment); err != nil {
+			return Server{}, err
+		}
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/application/servers", c.HostURL), c.prepareBody(server))
+	if err != nil {
+		return Server{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Server{}, err
+	}
+
+	var response ServerResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return Server{}, err
+	}
+
+	newServer := response.Attributes
+
+	return newServer, nil
+}
+
+// UpdateServerStartup - Updates the startup command, environment and image of a server
+func (c *Client) UpdateServerStartup(serverID int32, startup ServerStartup) (Server, error) {
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/application/servers/%d/startup", c.HostURL, serverID), c.prepareBody(startup))
+	if err != nil {
+		return Server{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Server{}, err
+	}
+
+	var response ServerResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return Server{}, err
+	}
+
+	updatedServer := response.Attributes
+
+	return updatedServer, nil
+}
+
+// UpdateServerDetails - Updates the name, owner, description and external ID of a server
+func (c *Client) UpdateServerDetails(serverID int32, details ServerDetails) (Server, error) {
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/application/servers/%d/details", c.HostURL, serverID), c.prepareBody(details))
+	if err != nil {
+		return Server{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Server{}, err
+	}
+
+	var response ServerResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return Server{}, err
+	}
+
+	server := response.Attributes
+
+	return server, nil
+}
+
+// SuspendServer - Suspends a server
+func (c *Client) SuspendServer(serverID int32) error {
+	return c.serverAction(serverID, "suspend")
+}
+
+// UnsuspendServer - Lifts the suspension of a server
+func (c *Client) UnsuspendServer(serverID int32) error {
+	return c.serverAction(serverID, "unsuspend")
+}
+
+func (c *Client) serverAction(serverID int32, action string) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/application/servers/%d/%s", c.HostURL, serverID, action), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteServer - Deletes a server, force deletes it from the panel even when the daemon cannot be reached
+func (c *Client) DeleteServer(serverID int32, force bool) error {
+	endpoint := fmt.Sprintf("%s/api/application/servers/%d", c.HostURL, serverID)
+	if force {
+		endpoint += "/force"
+	}
+
+	req, err := http.NewRequest("DELETE", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package pterodactyl
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// UnresolvedPlaceholdersError - Returned when a startup command references values that are not set
+type UnresolvedPlaceholdersError struct {
+	Placeholders []string
+}
+
+func (e *UnresolvedPlaceholdersError) Error() string {
+	return fmt.Sprintf("unresolved placeholders: %s", strings.Join(e.Placeholders, ", "))
+}
+
+var startupPlaceholder = regexp.MustCompile(`{{\s*([\w.-]+)\s*}}`)
+
+// RenderStartupCommand - Substitutes the {{VAR}} placeholders of a startup command with the values of a server
+//
+// Variables resolve from the server environment, including SERVER_MEMORY, SERVER_IP and SERVER_PORT,
+// and dotted keys such as {{server.build.default.port}} resolve as they do in configuration files.
+func RenderStartupCommand(startup string, env ConfigEnvironment) (string, error) {
+	variables := env.env()
+
+	var unresolved []string
+	seen := map[string]bool{}
+	rendered := startupPlaceholder.ReplaceAllStringFunc(startup, func(placeholder string) string {
+		key := startupPlaceholder.FindStringSubmatch(placeholder)[1]
+
+		value, ok := variables[key]
+		if !ok && strings.Contains(key, ".") {
+			value, ok = env.lookup(key)
+		}
+		if !ok {
+			if !seen[key] {
+				seen[key] = true
+				unresolved = append(unresolved, key)
+			}
+			return placeholder
+		}
+
+		return value
+	})
+
+	if len(unresolved) > 0 {
+		return rendered, &UnresolvedPlaceholdersError{Placeholders: unresolved}
+	}
+
+	return rendered, nil
+}
+
+// PreviewServerStartup - Renders the startup command of a server with its primary allocation
+func PreviewServerStartup(server Server, allocation Allocation) (string, error) {
+	return RenderStartupCommand(server.Container.StartupCommand, NewConfigEnvironment(server, allocation))
+}
+
+// GetServerStartupPreview - Returns the startup command a server will run
+func (c *Client) GetServerStartupPreview(serverID int32) (string, error) {
+	server, err := c.GetServer(serverID, ServerIncludeAllocations)
+	if err != nil {
+		return "", err
+	}
+
+	for _, allocationData := range server.Relationships.Allocations.Data {
+		if allocationData.Attributes.ID == server.Allocation {
+			return PreviewServerStartup(server, allocationData.Attributes)
+		}
+	}
+
+	return "", fmt.Errorf("primary allocation %d of server %d not found", server.Allocation, serverID)
+}
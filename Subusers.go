@@ -0,0 +1,48 @@
+package pterodactyl
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetSubusers - Returns the subusers of a server through the client API
+func (c *Client) GetSubusers(identifier string) ([]Subuser, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/client/servers/%s/users", c.HostURL, identifier), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, c.clientToken())
+	if err != nil {
+		return nil, err
+	}
+
+	var subuserList SubusersResponse
+	err = json.Unmarshal(body, &subuserList)
+	if err != nil {
+		return nil, err
+	}
+
+	subusers := make([]Subuser, len(subuserList.Data))
+	for i, subuserData := range subuserList.Data {
+		subusers[i] = subuserData.Attributes
+	}
+
+	return subusers, nil
+}
+
+// DeleteSubuser - Removes the access of a user to a server through the client API
+func (c *Client) DeleteSubuser(identifier string, userUUID string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/client/servers/%s/users/%s", c.HostURL, identifier, userUUID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req, c.clientToken())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
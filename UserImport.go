@@ -0,0 +1,390 @@
+package pterodactyl
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// User fields an import column can map to, named as in the create-user request
+const (
+	UserFieldExternalID = "external_id"
+	UserFieldUsername   = "username"
+	UserFieldEmail      = "email"
+	UserFieldFirstName  = "first_name"
+	UserFieldLastName   = "last_name"
+	UserFieldPassword   = "password"
+	UserFieldLanguage   = "language"
+	UserFieldRootAdmin  = "root_admin"
+)
+
+// Outcomes of an imported row
+const (
+	UserImportCreated = "created"
+	UserImportUpdated = "updated"
+	UserImportSkipped = "skipped"
+	UserImportFailed  = "failed"
+)
+
+// UserImportRow - User read from an import file
+type UserImportRow struct {
+	// Line - Line of a CSV file or position in a JSON array, starting at 1
+	Line int
+	User PartialUser
+	// Fields - User fields the row sets, other fields are left alone when updating
+	Fields map[string]bool
+}
+
+// Patch - Returns an update that changes only the fields the row sets
+func (r UserImportRow) Patch() UserPatch {
+	var patch UserPatch
+	if r.Fields[UserFieldExternalID] {
+		patch.ExternalID = Ptr(r.User.ExternalID)
+	}
+	if r.Fields[UserFieldUsername] {
+		patch.Username = Ptr(r.User.Username)
+	}
+	if r.Fields[UserFieldEmail] {
+		patch.Email = Ptr(r.User.Email)
+	}
+	if r.Fields[UserFieldFirstName] {
+		patch.FirstName = Ptr(r.User.FirstName)
+	}
+	if r.Fields[UserFieldLastName] {
+		patch.LastName = Ptr(r.User.LastName)
+	}
+	if r.Fields[UserFieldPassword] {
+		patch.Password = Ptr(r.User.Password)
+	}
+	if r.Fields[UserFieldLanguage] {
+		patch.Language = Ptr(r.User.Language)
+	}
+	if r.Fields[UserFieldRootAdmin] {
+		patch.RootAdmin = Ptr(r.User.RootAdmin)
+	}
+	return patch
+}
+
+// patchChangesUser - Reports whether applying a patch would change a user
+func patchChangesUser(patch UserPatch, user User) bool {
+	differs := func(field *string, current string) bool {
+		return field != nil && *field != current
+	}
+
+	return differs(patch.ExternalID, user.ExternalID) ||
+		differs(patch.Username, user.Username) ||
+		differs(patch.Email, user.Email) ||
+		differs(patch.FirstName, user.FirstName) ||
+		differs(patch.LastName, user.LastName) ||
+		differs(patch.Language, user.Language) ||
+		(patch.RootAdmin != nil && *patch.RootAdmin != user.RootAdmin) ||
+		patch.Password != nil
+}
+
+// set - Assigns a value to a user field, empty values leave the field unset
+func (r *UserImportRow) set(field, value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+
+	switch field {
+	case UserFieldExternalID:
+		r.User.ExternalID = value
+	case UserFieldUsername:
+		r.User.Username = value
+	case UserFieldEmail:
+		r.User.Email = value
+	case UserFieldFirstName:
+		r.User.FirstName = value
+	case UserFieldLastName:
+		r.User.LastName = value
+	case UserFieldPassword:
+		r.User.Password = value
+	case UserFieldLanguage:
+		r.User.Language = value
+	case UserFieldRootAdmin:
+		rootAdmin, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("line %d: %s must be true or false, got %q", r.Line, field, value)
+		}
+		r.User.RootAdmin = rootAdmin
+	default:
+		return fmt.Errorf("unknown user field %s", field)
+	}
+
+	r.Fields[field] = true
+	return nil
+}
+
+// importField - Returns the user field a column maps to, columns are matched to field names when there is no mapping
+func importField(columns map[string]string, column string) (string, bool) {
+	if columns == nil {
+		column = strings.ToLower(strings.TrimSpace(column))
+		switch column {
+		case UserFieldExternalID, UserFieldUsername, UserFieldEmail, UserFieldFirstName,
+			UserFieldLastName, UserFieldPassword, UserFieldLanguage, UserFieldRootAdmin:
+			return column, true
+		}
+		return "", false
+	}
+
+	field, ok := columns[column]
+	return field, ok
+}
+
+// ReadUserImportCSV - Reads users from a CSV file with a header line
+//
+// columns maps header names to user fields, columns without a mapping are ignored.
+// When columns is nil the headers must be the user field names themselves.
+func ReadUserImportCSV(r io.Reader, columns map[string]string) ([]UserImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []UserImportRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := UserImportRow{Line: line, Fields: map[string]bool{}}
+		for i, value := range record {
+			if i >= len(header) {
+				break
+			}
+			field, ok := importField(columns, header[i])
+			if !ok {
+				continue
+			}
+			if err := row.set(field, value); err != nil {
+				return nil, err
+			}
+		}
+		rows = append(rows, row)
+	}
+}
+
+// ReadUserImportJSON - Reads users from a JSON array of objects
+//
+// columns maps object keys to user fields the same way as for ReadUserImportCSV.
+func ReadUserImportJSON(r io.Reader, columns map[string]string) ([]UserImportRow, error) {
+	var objects []map[string]interface{}
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	if err := decoder.Decode(&objects); err != nil {
+		return nil, err
+	}
+
+	rows := make([]UserImportRow, len(objects))
+	for i, object := range objects {
+		rows[i] = UserImportRow{Line: i + 1, Fields: map[string]bool{}}
+		for key, value := range object {
+			field, ok := importField(columns, key)
+			if !ok || value == nil {
+				continue
+			}
+			if err := rows[i].set(field, fmt.Sprint(value)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return rows, nil
+}
+
+// UserImportOptions - Options for ImportUsers
+type UserImportOptions struct {
+	// UpdateExisting - Update users that already exist instead of skipping them
+	UpdateExisting bool
+	// Concurrency - Number of users created or updated at once (defaults to 4)
+	Concurrency int
+}
+
+// UserImportResult - Outcome of a single imported row
+type UserImportResult struct {
+	Line       int    `json:"line"`
+	Email      string `json:"email"`
+	Username   string `json:"username"`
+	ExternalID string `json:"external_id"`
+	Action     string `json:"action"`
+	UserID     int32  `json:"user_id,omitempty"`
+	Err        error  `json:"-"`
+}
+
+// Error - Returns the reason the row failed, empty when it did not
+func (r UserImportResult) Error() string {
+	if r.Err == nil {
+		return ""
+	}
+	return r.Err.Error()
+}
+
+// MarshalJSON - Includes the failure reason as a string
+func (r UserImportResult) MarshalJSON() ([]byte, error) {
+	type result UserImportResult
+	return json.Marshal(struct {
+		result
+		Error string `json:"error,omitempty"`
+	}{result(r), r.Error()})
+}
+
+// UserImportReport - Outcome of ImportUsers, one result per row in the order of the rows
+type UserImportReport struct {
+	Results []UserImportResult
+}
+
+// Count - Returns the number of rows with an action
+func (r UserImportReport) Count(action string) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+// WriteCSV - Writes the report as CSV with a header line
+func (r UserImportReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"line", "email", "username", "external_id", "action", "user_id", "error"}); err != nil {
+		return err
+	}
+
+	for _, result := range r.Results {
+		userID := ""
+		if result.UserID != 0 {
+			userID = strconv.FormatInt(int64(result.UserID), 10)
+		}
+
+		record := []string{strconv.Itoa(result.Line), result.Email, result.Username, result.ExternalID, result.Action, userID, result.Error()}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteJSON - Writes the report as a JSON array
+func (r UserImportReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	results := r.Results
+	if results == nil {
+		results = []UserImportResult{}
+	}
+	return encoder.Encode(results)
+}
+
+// ImportUsers - Creates the users of an import, matching existing users by email or external ID
+//
+// Existing users are skipped unless UpdateExisting is set, in which case only the fields the row sets are changed
+// and PatchUser keeps the current values of the rest. Rows that would change nothing are skipped. Failures are recorded per row and do not stop the import.
+func (c *Client) ImportUsers(rows []UserImportRow, opts UserImportOptions) (UserImportReport, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	users, err := c.GetUsers()
+	if err != nil {
+		return UserImportReport{}, err
+	}
+
+	byEmail := map[string]User{}
+	byExternalID := map[string]User{}
+	for _, user := range users {
+		byEmail[strings.ToLower(user.Email)] = user
+		if user.ExternalID != "" {
+			byExternalID[user.ExternalID] = user
+		}
+	}
+
+	report := UserImportReport{Results: make([]UserImportResult, len(rows))}
+	seenEmail := map[string]int{}
+	seenExternalID := map[string]int{}
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency && i < len(rows); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				row := rows[index]
+				result := &report.Results[index]
+
+				existing, found := byExternalID[row.User.ExternalID]
+				if !found || row.User.ExternalID == "" {
+					existing, found = byEmail[strings.ToLower(row.User.Email)]
+				}
+
+				switch {
+				case !found:
+					user, err := c.CreateUser(row.User)
+					result.Action, result.UserID, result.Err = UserImportCreated, user.ID, err
+				case !opts.UpdateExisting:
+					result.Action, result.UserID = UserImportSkipped, existing.ID
+				case !patchChangesUser(row.Patch(), existing):
+					result.Action, result.UserID = UserImportSkipped, existing.ID
+				default:
+					user, err := c.PatchUser(existing.ID, row.Patch())
+					result.Action, result.UserID, result.Err = UserImportUpdated, existing.ID, err
+					if err == nil {
+						result.UserID = user.ID
+					}
+				}
+				if result.Err != nil {
+					result.Action = UserImportFailed
+				}
+			}
+		}()
+	}
+
+	for index, row := range rows {
+		report.Results[index] = UserImportResult{
+			Line:       row.Line,
+			Email:      row.User.Email,
+			Username:   row.User.Username,
+			ExternalID: row.User.ExternalID,
+		}
+
+		// Rows for the same user would race each other, so only the first one is imported
+		email := strings.ToLower(row.User.Email)
+		if line, ok := seenEmail[email]; ok && email != "" {
+			report.Results[index].Action = UserImportFailed
+			report.Results[index].Err = fmt.Errorf("duplicate of line %d", line)
+			continue
+		}
+		if line, ok := seenExternalID[row.User.ExternalID]; ok && row.User.ExternalID != "" {
+			report.Results[index].Action = UserImportFailed
+			report.Results[index].Err = fmt.Errorf("duplicate of line %d", line)
+			continue
+		}
+		seenEmail[email] = row.Line
+		seenExternalID[row.User.ExternalID] = row.Line
+
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	return report, nil
+}
@@ -0,0 +1,489 @@
+package pterodactyl
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// DesiredUser - User as an identity source wants it to exist in the panel, keyed by ExternalID
+type DesiredUser struct {
+	ExternalID string `json:"external_id"`
+	Username   string `json:"username"`
+	Email      string `json:"email"`
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	Language   string `json:"language,omitempty"`
+	// RootAdmin - Left alone on existing users when nil, new users are not root admins unless set
+	RootAdmin *bool `json:"root_admin,omitempty"`
+}
+
+// UserSource - Provides the users that should exist in the panel
+type UserSource interface {
+	Users() ([]DesiredUser, error)
+}
+
+// JSONUserSource - Reads the desired users from a JSON file holding an array of users
+type JSONUserSource struct {
+	Path string
+}
+
+// Users -
+func (s JSONUserSource) Users() ([]DesiredUser, error) {
+	data, err := os.ReadFile(s.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []DesiredUser
+	err = json.Unmarshal(data, &users)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.Path, err)
+	}
+
+	return users, nil
+}
+
+// DirectoryEntry - Entry returned by a directory search
+type DirectoryEntry struct {
+	DN         string
+	Attributes map[string][]string
+}
+
+// Attribute - Returns the first value of an attribute, attribute names are case insensitive
+func (e DirectoryEntry) Attribute(name string) string {
+	for attribute, values := range e.Attributes {
+		if strings.EqualFold(attribute, name) && len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// HasValue - Reports whether any value of an attribute equals value, ignoring case
+func (e DirectoryEntry) HasValue(name, value string) bool {
+	for attribute, values := range e.Attributes {
+		if !strings.EqualFold(attribute, name) {
+			continue
+		}
+		for _, v := range values {
+			if strings.EqualFold(v, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DirectorySearcher - Searches an LDAP-style directory, implemented on top of the LDAP library of the caller
+type DirectorySearcher interface {
+	Search(baseDN, filter string, attributes []string) ([]DirectoryEntry, error)
+}
+
+// DirectoryAttributes - Directory attributes holding the fields of a user, empty names fall back to the defaults
+type DirectoryAttributes struct {
+	// ExternalID - Defaults to entryUUID
+	ExternalID string
+	// Username - Defaults to uid
+	Username string
+	// Email - Defaults to mail
+	Email string
+	// FirstName - Defaults to givenName
+	FirstName string
+	// LastName - Defaults to sn
+	LastName string
+	// Language - Not read when empty
+	Language string
+	// AdminAttribute and AdminValue - Users with AdminValue among the values of AdminAttribute become root admins,
+	// such as memberOf and the DN of an admin group, the root admin flag is not synced when AdminAttribute is empty
+	AdminAttribute string
+	AdminValue     string
+}
+
+func (a DirectoryAttributes) withDefaults() DirectoryAttributes {
+	defaults := map[*string]string{
+		&a.ExternalID: "entryUUID",
+		&a.Username:   "uid",
+		&a.Email:      "mail",
+		&a.FirstName:  "givenName",
+		&a.LastName:   "sn",
+	}
+	for field, value := range defaults {
+		if *field == "" {
+			*field = value
+		}
+	}
+	return a
+}
+
+// DirectoryUserSource - Reads the desired users from an LDAP-style directory
+type DirectoryUserSource struct {
+	Searcher   DirectorySearcher
+	BaseDN     string
+	Filter     string
+	Attributes DirectoryAttributes
+}
+
+// Users -
+func (s DirectoryUserSource) Users() ([]DesiredUser, error) {
+	attributes := s.Attributes.withDefaults()
+	requested := []string{attributes.ExternalID, attributes.Username, attributes.Email, attributes.FirstName, attributes.LastName}
+	if attributes.Language != "" {
+		requested = append(requested, attributes.Language)
+	}
+	if attributes.AdminAttribute != "" {
+		requested = append(requested, attributes.AdminAttribute)
+	}
+
+	entries, err := s.Searcher.Search(s.BaseDN, s.Filter, requested)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]DesiredUser, len(entries))
+	for i, entry := range entries {
+		users[i] = DesiredUser{
+			ExternalID: entry.Attribute(attributes.ExternalID),
+			Username:   entry.Attribute(attributes.Username),
+			Email:      entry.Attribute(attributes.Email),
+			FirstName:  entry.Attribute(attributes.FirstName),
+			LastName:   entry.Attribute(attributes.LastName),
+		}
+		if attributes.Language != "" {
+			users[i].Language = entry.Attribute(attributes.Language)
+		}
+		if attributes.AdminAttribute != "" {
+			users[i].RootAdmin = Ptr(entry.HasValue(attributes.AdminAttribute, attributes.AdminValue))
+		}
+	}
+
+	return users, nil
+}
+
+// SyncConflictPolicy - How a sync treats a panel user without the external ID that holds the email or username of a desired user
+type SyncConflictPolicy string
+
+const (
+	// SyncConflictSkip - Leave the desired user out and report the conflict
+	SyncConflictSkip SyncConflictPolicy = "skip"
+	// SyncConflictAdopt - Link the panel user to the external ID when it is not linked to another one
+	SyncConflictAdopt SyncConflictPolicy = "adopt"
+	// SyncConflictRename - Create the user with a numbered username, only used for username conflicts
+	SyncConflictRename SyncConflictPolicy = "rename"
+)
+
+// Outcomes of a synced user
+const (
+	UserSyncCreated   = "created"
+	UserSyncUpdated   = "updated"
+	UserSyncAdopted   = "adopted"
+	UserSyncDeleted   = "deleted"
+	UserSyncUnchanged = "unchanged"
+	UserSyncConflict  = "conflict"
+	UserSyncFailed    = "failed"
+)
+
+// UserSyncOptions - Options for SyncUsers
+type UserSyncOptions struct {
+	// EmailConflict - Defaults to SyncConflictSkip
+	EmailConflict SyncConflictPolicy
+	// UsernameConflict - Defaults to SyncConflictSkip
+	UsernameConflict SyncConflictPolicy
+	// Delete - Delete panel users with an external ID the source no longer has
+	Delete bool
+	// Managed - Limits deletion to the users it returns true for, every user with an external ID when nil
+	Managed func(User) bool
+	// MaxDeletions - Refuse to delete anything when more users would be deleted, unlimited when zero
+	MaxDeletions int
+	// DryRun - Report the changes without making them
+	DryRun bool
+}
+
+// UserSyncResult - Outcome for a single user
+type UserSyncResult struct {
+	ExternalID string
+	UserID     int32
+	Action     string
+	Detail     string
+	Err        error
+}
+
+// UserSyncReport - Outcome of SyncUsers
+type UserSyncReport struct {
+	DryRun  bool
+	Results []UserSyncResult
+}
+
+// Count - Returns the number of users with an action
+func (r UserSyncReport) Count(action string) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+// userIndex - Panel users by the keys a sync matches on
+type userIndex struct {
+	byExternalID map[string]User
+	byEmail      map[string]User
+	byUsername   map[string]User
+}
+
+func newUserIndex(users []User) userIndex {
+	index := userIndex{byExternalID: map[string]User{}, byEmail: map[string]User{}, byUsername: map[string]User{}}
+	for _, user := range users {
+		index.add(user)
+	}
+	return index
+}
+
+func (i userIndex) add(user User) {
+	if user.ExternalID != "" {
+		i.byExternalID[user.ExternalID] = user
+	}
+	i.byEmail[strings.ToLower(user.Email)] = user
+	i.byUsername[strings.ToLower(user.Username)] = user
+}
+
+func (i userIndex) remove(user User) {
+	delete(i.byExternalID, user.ExternalID)
+	delete(i.byEmail, strings.ToLower(user.Email))
+	delete(i.byUsername, strings.ToLower(user.Username))
+}
+
+// taken - Returns the user other than self holding an email or username
+func (i userIndex) taken(keys map[string]User, key string, self int32) (User, bool) {
+	user, ok := keys[strings.ToLower(key)]
+	return user, ok && user.ID != self
+}
+
+// freeUsername - Returns the first of username-2, username-3, ... that no user other than self holds
+func (i userIndex) freeUsername(username string, self int32) string {
+	for n := 2; ; n++ {
+		candidate := fmt.Sprintf("%s-%d", username, n)
+		if _, taken := i.taken(i.byUsername, candidate, self); !taken {
+			return candidate
+		}
+	}
+}
+
+// syncPatch - Returns the changes that make a panel user match a desired user
+func syncPatch(user User, desired DesiredUser) (UserPatch, bool) {
+	var patch UserPatch
+	changed := false
+	set := func(field **string, current, want string) {
+		if want != "" && current != want {
+			*field = Ptr(want)
+			changed = true
+		}
+	}
+
+	set(&patch.ExternalID, user.ExternalID, desired.ExternalID)
+	set(&patch.Username, user.Username, desired.Username)
+	set(&patch.Email, user.Email, desired.Email)
+	set(&patch.FirstName, user.FirstName, desired.FirstName)
+	set(&patch.LastName, user.LastName, desired.LastName)
+	set(&patch.Language, user.Language, desired.Language)
+	if desired.RootAdmin != nil && user.RootAdmin != *desired.RootAdmin {
+		patch.RootAdmin = Ptr(*desired.RootAdmin)
+		changed = true
+	}
+
+	return patch, changed
+}
+
+// SyncUsers - Creates, updates and optionally deletes panel users to match the users of a source by external ID
+//
+// Users are matched by external ID only. A desired user whose email or username belongs to another panel user
+// is handled by the conflict policies. Panel users without an external ID are never deleted.
+func (c *Client) SyncUsers(source UserSource, opts UserSyncOptions) (UserSyncReport, error) {
+	desired, err := source.Users()
+	if err != nil {
+		return UserSyncReport{}, err
+	}
+	// An empty source is far more likely a broken search than a directory without users
+	if opts.Delete && len(desired) == 0 {
+		return UserSyncReport{}, fmt.Errorf("source returned no users, refusing to delete every synced user")
+	}
+
+	users, err := c.GetUsers()
+	if err != nil {
+		return UserSyncReport{}, err
+	}
+
+	s := userSync{client: c, opts: opts, index: newUserIndex(users), report: UserSyncReport{DryRun: opts.DryRun}}
+	wanted := map[string]bool{}
+	for _, user := range desired {
+		if user.ExternalID == "" {
+			s.record(UserSyncResult{Action: UserSyncFailed, Detail: user.Email, Err: fmt.Errorf("user %s has no external ID", user.Email)})
+			continue
+		}
+		if wanted[user.ExternalID] {
+			s.record(UserSyncResult{ExternalID: user.ExternalID, Action: UserSyncFailed, Err: fmt.Errorf("external ID %s appears more than once in the source", user.ExternalID)})
+			continue
+		}
+		wanted[user.ExternalID] = true
+
+		s.sync(user)
+	}
+
+	if opts.Delete {
+		var stale []User
+		for _, user := range users {
+			if user.ExternalID == "" || wanted[user.ExternalID] {
+				continue
+			}
+			if opts.Managed != nil && !opts.Managed(user) {
+				continue
+			}
+			stale = append(stale, user)
+		}
+
+		if opts.MaxDeletions > 0 && len(stale) > opts.MaxDeletions {
+			return s.report, fmt.Errorf("sync would delete %d users, more than the limit of %d", len(stale), opts.MaxDeletions)
+		}
+
+		for _, user := range stale {
+			result := UserSyncResult{ExternalID: user.ExternalID, UserID: user.ID, Action: UserSyncDeleted, Detail: user.Username}
+			if !opts.DryRun {
+				result.Err = c.DeleteUser(user.ID)
+			}
+			s.record(result)
+		}
+	}
+
+	return s.report, nil
+}
+
+type userSync struct {
+	client *Client
+	opts   UserSyncOptions
+	index  userIndex
+	report UserSyncReport
+}
+
+func (s *userSync) record(result UserSyncResult) {
+	if result.Err != nil {
+		result.Action = UserSyncFailed
+	}
+	s.report.Results = append(s.report.Results, result)
+}
+
+func (s *userSync) sync(desired DesiredUser) {
+	result := UserSyncResult{ExternalID: desired.ExternalID}
+
+	if existing, ok := s.index.byExternalID[desired.ExternalID]; ok {
+		result.UserID = existing.ID
+		if other, taken := s.index.taken(s.index.byEmail, desired.Email, existing.ID); taken {
+			result.Action, result.Detail = UserSyncConflict, fmt.Sprintf("email %s belongs to user %d", desired.Email, other.ID)
+			s.record(result)
+			return
+		}
+		if _, taken := s.index.taken(s.index.byUsername, desired.Username, existing.ID); taken {
+			if s.opts.UsernameConflict != SyncConflictRename {
+				result.Action, result.Detail = UserSyncConflict, fmt.Sprintf("username %s is taken", desired.Username)
+				s.record(result)
+				return
+			}
+			desired.Username = s.index.freeUsername(desired.Username, existing.ID)
+		}
+
+		s.update(existing, desired, UserSyncUpdated, result)
+		return
+	}
+
+	if other, taken := s.index.byEmail[strings.ToLower(desired.Email)]; taken {
+		if s.opts.EmailConflict != SyncConflictAdopt || other.ExternalID != "" {
+			result.Action, result.Detail = UserSyncConflict, fmt.Sprintf("email %s belongs to user %d", desired.Email, other.ID)
+			s.record(result)
+			return
+		}
+		s.adopt(other, desired, "email", result)
+		return
+	}
+
+	if other, taken := s.index.byUsername[strings.ToLower(desired.Username)]; taken {
+		switch {
+		case s.opts.UsernameConflict == SyncConflictAdopt && other.ExternalID == "":
+			s.adopt(other, desired, "username", result)
+			return
+		case s.opts.UsernameConflict == SyncConflictRename:
+			desired.Username = s.index.freeUsername(desired.Username, 0)
+		default:
+			result.Action, result.Detail = UserSyncConflict, fmt.Sprintf("username %s belongs to user %d", desired.Username, other.ID)
+			s.record(result)
+			return
+		}
+	}
+
+	result.Action, result.Detail = UserSyncCreated, desired.Username
+	newUser := PartialUser{
+		ExternalID: desired.ExternalID,
+		Username:   desired.Username,
+		Email:      desired.Email,
+		FirstName:  desired.FirstName,
+		LastName:   desired.LastName,
+		Language:   desired.Language,
+		RootAdmin:  desired.RootAdmin != nil && *desired.RootAdmin,
+	}
+	if s.opts.DryRun {
+		result.Err = newUser.Validate()
+		s.record(result)
+		return
+	}
+
+	user, err := s.client.CreateUser(newUser)
+	result.UserID, result.Err = user.ID, err
+	if err == nil {
+		s.index.add(user)
+	}
+	s.record(result)
+}
+
+// adopt - Links a panel user matched by email or username to the external ID of a desired user
+func (s *userSync) adopt(user User, desired DesiredUser, matchedBy string, result UserSyncResult) {
+	if other, taken := s.index.taken(s.index.byUsername, desired.Username, user.ID); taken {
+		result.UserID, result.Action = user.ID, UserSyncConflict
+		result.Detail = fmt.Sprintf("matched user %d by %s but username %s belongs to user %d", user.ID, matchedBy, desired.Username, other.ID)
+		s.record(result)
+		return
+	}
+	if other, taken := s.index.taken(s.index.byEmail, desired.Email, user.ID); taken {
+		result.UserID, result.Action = user.ID, UserSyncConflict
+		result.Detail = fmt.Sprintf("matched user %d by %s but email %s belongs to user %d", user.ID, matchedBy, desired.Email, other.ID)
+		s.record(result)
+		return
+	}
+
+	result.Detail = "matched by " + matchedBy
+	s.update(user, desired, UserSyncAdopted, result)
+}
+
+func (s *userSync) update(user User, desired DesiredUser, action string, result UserSyncResult) {
+	result.UserID = user.ID
+	patch, changed := syncPatch(user, desired)
+	if !changed {
+		result.Action = UserSyncUnchanged
+		s.record(result)
+		return
+	}
+
+	result.Action = action
+	if s.opts.DryRun {
+		result.Err = patch.Validate()
+		s.record(result)
+		return
+	}
+
+	updated, err := s.client.PatchUser(user.ID, patch)
+	result.Err = err
+	if err == nil {
+		s.index.remove(user)
+		s.index.add(updated)
+	}
+	s.record(result)
+}
@@ -0,0 +1,128 @@
+package pterodactyl
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// usernamePattern - Usernames the panel accepts, compared after lowercasing
+var usernamePattern = regexp.MustCompile(`^[a-z0-9]([\w.-]+)[a-z0-9]$`)
+
+// userFieldLimit - Longest value the panel stores for the string fields of a user
+const userFieldLimit = 191
+
+// UserFieldError - Field of a user request that the panel would reject
+type UserFieldError struct {
+	Field   string
+	Message string
+}
+
+// UserValidationError - Returned when a user request does not pass the checks of the panel
+type UserValidationError struct {
+	Fields []UserFieldError
+}
+
+func (e *UserValidationError) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		messages[i] = fmt.Sprintf("%s: %s", field.Field, field.Message)
+	}
+
+	return "invalid user: " + strings.Join(messages, "; ")
+}
+
+// ValidateUsername - Checks a username against the format the panel requires
+func ValidateUsername(username string) error {
+	if utf8.RuneCountInString(username) > userFieldLimit {
+		return fmt.Errorf("username must be at most %d characters", userFieldLimit)
+	}
+	if !usernamePattern.MatchString(strings.ToLower(username)) {
+		return fmt.Errorf("username %q must start and end with a letter or number and may only contain letters, numbers, dashes, underscores and periods", username)
+	}
+
+	return nil
+}
+
+// ValidateEmail - Checks that an email is a bare address
+func ValidateEmail(email string) error {
+	if utf8.RuneCountInString(email) > userFieldLimit {
+		return fmt.Errorf("email must be at most %d characters", userFieldLimit)
+	}
+
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return fmt.Errorf("email %q is not a valid address", email)
+	}
+
+	return nil
+}
+
+type userFieldErrors []UserFieldError
+
+func (e *userFieldErrors) check(field string, err error) {
+	if err != nil {
+		*e = append(*e, UserFieldError{Field: field, Message: err.Error()})
+	}
+}
+
+func (e *userFieldErrors) name(field, value string) {
+	switch {
+	case value == "":
+		*e = append(*e, UserFieldError{Field: field, Message: "is required"})
+	case utf8.RuneCountInString(value) > userFieldLimit:
+		*e = append(*e, UserFieldError{Field: field, Message: fmt.Sprintf("must be at most %d characters", userFieldLimit)})
+	}
+}
+
+func (e *userFieldErrors) externalID(value string) {
+	if utf8.RuneCountInString(value) > userFieldLimit {
+		*e = append(*e, UserFieldError{Field: "external_id", Message: fmt.Sprintf("must be at most %d characters", userFieldLimit)})
+	}
+}
+
+func (e userFieldErrors) err() error {
+	if len(e) > 0 {
+		return &UserValidationError{Fields: e}
+	}
+	return nil
+}
+
+// Validate - Checks the fields of a new user before it is sent to the panel
+func (p PartialUser) Validate() error {
+	var errs userFieldErrors
+	errs.check("username", ValidateUsername(p.Username))
+	errs.check("email", ValidateEmail(p.Email))
+	errs.name("first_name", p.FirstName)
+	errs.name("last_name", p.LastName)
+	errs.externalID(p.ExternalID)
+
+	return errs.err()
+}
+
+// Validate - Checks the fields set in a user patch before it is sent to the panel
+func (p UserPatch) Validate() error {
+	var errs userFieldErrors
+	if p.Username != nil {
+		errs.check("username", ValidateUsername(*p.Username))
+	}
+	if p.Email != nil {
+		errs.check("email", ValidateEmail(*p.Email))
+	}
+	if p.FirstName != nil {
+		errs.name("first_name", *p.FirstName)
+	}
+	if p.LastName != nil {
+		errs.name("last_name", *p.LastName)
+	}
+	if p.ExternalID != nil {
+		errs.externalID(*p.ExternalID)
+	}
+	if p.Password != nil && *p.Password == "" {
+		errs = append(errs, UserFieldError{Field: "password", Message: "must not be empty when set"})
+	}
+
+	return errs.err()
+}
@@ -4,67 +4,46 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // GetUsers - Returns list of users
 func (c *Client) GetUsers() ([]User, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/users", c.HostURL), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var userList UsersResponse
-	err = json.Unmarshal(body, &userList)
-	if err != nil {
-		return nil, err
-	}
-
-	userResponses := userList.Data
-
-	users := make([]User, len(userResponses))
-	for i, userResponse := range userResponses {
-		users[i] = userResponse.Attributes
-	}
-
-	return users, nil
+	return c.getUsers(url.Values{})
 }
 
 // GetUsersWithFilter - Returns list of users with filter
 func (c *Client) GetUsersWithFilter(filterBy string, filterContent string) ([]User, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/users?filter[%s]=%s", c.HostURL, filterBy, filterContent), nil)
-	if err != nil {
-		return nil, err
-	}
+	query := url.Values{}
+	query.Set(fmt.Sprintf("filter[%s]", filterBy), filterContent)
 
-	body, err := c.doRequest(req, nil)
-	if err != nil {
-		return nil, err
-	}
+	return c.getUsers(query)
+}
 
-	var userList UsersResponse
-	err = json.Unmarshal(body, &userList)
-	if err != nil {
-		return nil, err
-	}
+func (c *Client) getUsers(query url.Values) ([]User, error) {
+	users := make([]User, 0)
+	err := c.getAllPages("/api/application/users", query, func(body []byte) (Pagination, error) {
+		var userList UsersResponse
+		if err := json.Unmarshal(body, &userList); err != nil {
+			return Pagination{}, err
+		}
 
-	userResponses := userList.Data
+		for _, userData := range userList.Data {
+			users = append(users, userData.Attributes)
+		}
 
-	users := make([]User, len(userResponses))
-	for i, userResponse := range userResponses {
-		users[i] = userResponse.Attributes
+		return userList.Meta.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return users, nil
 }
 
 // GetUser - Returns specific user
-func (c *Client) GetUser(userID int32) (User, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/users/%d", c.HostURL, userID), nil)
+func (c *Client) GetUser(userID int32, include ...UserInclude) (User, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/users/%d%s", c.HostURL, userID, includeQuery(include)), nil)
 	if err != nil {
 		return User{}, err
 	}
@@ -87,27 +66,15 @@ func (c *Client) GetUser(userID int32) (User, error) {
 
 // GetUserEmail - Returns specific user by email
 func (c *Client) GetUserEmail(email string) (User, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/users/", c.HostURL), nil)
-	if err != nil {
-		return User{}, err
-	}
-
-	body, err := c.doRequest(req, nil)
+	users, err := c.GetUsersWithFilter("email", email)
 	if err != nil {
 		return User{}, err
 	}
 
-	var userList UsersResponse
-	err = json.Unmarshal(body, &userList)
-	if err != nil {
-		return User{}, err
-	}
-
-	userResponses := userList.Data
-
-	for _, userResponse := range userResponses {
-		if userResponse.Attributes.Email == email {
-			return userResponse.Attributes, nil
+	// The filter matches partially, so look for the exact email
+	for _, user := range users {
+		if user.Email == email {
+			return user, nil
 		}
 	}
 
@@ -116,27 +83,14 @@ func (c *Client) GetUserEmail(email string) (User, error) {
 
 // GetUserUsername - Returns specific user by username
 func (c *Client) GetUserUsername(username string) (User, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/application/users/", c.HostURL), nil)
-	if err != nil {
-		return User{}, err
-	}
-
-	body, err := c.doRequest(req, nil)
-	if err != nil {
-		return User{}, err
-	}
-
-	var userList UsersResponse
-	err = json.Unmarshal(body, &userList)
+	users, err := c.GetUsersWithFilter("username", username)
 	if err != nil {
 		return User{}, err
 	}
 
-	userResponses := userList.Data
-
-	for _, userResponse := range userResponses {
-		if userResponse.Attributes.Username == username {
-			return userResponse.Attributes, nil
+	for _, user := range users {
+		if user.Username == username {
+			return user, nil
 		}
 	}
 
@@ -168,6 +122,10 @@ func (c *Client) GetUserExternalID(externalID string) (User, error) {
 
 // CreateUser - Create new user
 func (c *Client) CreateUser(newUser PartialUser) (User, error) {
+	if err := newUser.Validate(); err != nil {
+		return User{}, err
+	}
+
 	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/application/users", c.HostURL), c.prepareBody(newUser))
 	if err != nil {
 		return User{}, err
@@ -189,16 +147,49 @@ func (c *Client) CreateUser(newUser PartialUser) (User, error) {
 	return user, nil
 }
 
-// UpdateUser - Update user
+// UpdateUser - Update every field of a user
+//
+// When the user provides GetExternalID, GetLanguage and GetRootAdmin, as User and PartialUser do, their values are
+// always sent, so an empty external ID clears it and a false root admin flag revokes it. The password is only changed
+// when GetPassword returns a non-empty value. Use PatchUser to leave fields unchanged.
 func (c *Client) UpdateUser(userID int32, userInterface UserInterface) (User, error) {
-	marshalledUser := PartialUser{
-		Email:     userInterface.GetEmail(),
-		Username:  userInterface.GetUsername(),
-		FirstName: userInterface.GetFirstName(),
-		LastName:  userInterface.GetLastName(),
+	patch := UserPatch{
+		Email:     Ptr(userInterface.GetEmail()),
+		Username:  Ptr(userInterface.GetUsername()),
+		FirstName: Ptr(userInterface.GetFirstName()),
+		LastName:  Ptr(userInterface.GetLastName()),
+	}
+	if withExternalID, ok := userInterface.(interface{ GetExternalID() string }); ok {
+		patch.ExternalID = Ptr(withExternalID.GetExternalID())
+	}
+	if withLanguage, ok := userInterface.(interface{ GetLanguage() string }); ok {
+		patch.Language = Ptr(withLanguage.GetLanguage())
+	}
+	if withRootAdmin, ok := userInterface.(interface{ GetRootAdmin() bool }); ok {
+		patch.RootAdmin = Ptr(withRootAdmin.GetRootAdmin())
+	}
+	if withPassword, ok := userInterface.(interface{ GetPassword() string }); ok && withPassword.GetPassword() != "" {
+		patch.Password = Ptr(withPassword.GetPassword())
+	}
+
+	return c.PatchUser(userID, patch)
+}
+
+// PatchUser - Update only the fields of a user set in the patch
+//
+// The panel requires the username, email and names on update, so the user is fetched and the fields left nil keep their current values.
+func (c *Client) PatchUser(userID int32, patch UserPatch) (User, error) {
+	if err := patch.Validate(); err != nil {
+		return User{}, err
+	}
+
+	current, err := c.GetUser(userID)
+	if err != nil {
+		return User{}, err
 	}
+	patch = patch.merge(current)
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/application/users/%d", c.HostURL, userID), c.prepareBody(marshalledUser))
+	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/application/users/%d", c.HostURL, userID), c.prepareBody(patch))
 	if err != nil {
 		return User{}, err
 	}
@@ -0,0 +1,134 @@
+package pterodactyl
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Validate - Checks that the configuration holds the values Wings cannot start without
+func (n NodeConfiguration) Validate() error {
+	var missing []string
+	if n.UUID == "" {
+		missing = append(missing, "uuid")
+	}
+	if n.TokenID == "" {
+		missing = append(missing, "token_id")
+	}
+	if n.Token == "" {
+		missing = append(missing, "token")
+	}
+	if n.Remote == "" {
+		missing = append(missing, "remote")
+	}
+	if n.API.Port == 0 {
+		missing = append(missing, "api.port")
+	}
+	if n.System.Data == "" {
+		missing = append(missing, "system.data")
+	}
+	if n.System.SFTP.BindPort == 0 {
+		missing = append(missing, "system.sftp.bind_port")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("invalid wings configuration, missing %v", missing)
+	}
+	if n.API.SSL.Enabled && (n.API.SSL.Cert == "" || n.API.SSL.Key == "") {
+		return errors.New("invalid wings configuration, ssl is enabled without cert and key")
+	}
+
+	return nil
+}
+
+// WriteWingsConfig - Writes the config.yml of a node, deep merging overrides such as {"docker": {"network": {"network_mtu": 1400}}} over it
+func WriteWingsConfig(w io.Writer, config NodeConfiguration, overrides map[string]interface{}) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	var document yaml.Node
+	if err := document.Encode(config); err != nil {
+		return err
+	}
+	if len(overrides) > 0 {
+		var overrideNode yaml.Node
+		if err := overrideNode.Encode(overrides); err != nil {
+			return err
+		}
+		mergeYAMLNodes(&document, &overrideNode)
+	}
+
+	var merged NodeConfiguration
+	if err := document.Decode(&merged); err != nil {
+		return fmt.Errorf("invalid wings configuration overrides: %w", err)
+	}
+	if err := merged.Validate(); err != nil {
+		return err
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&document); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+// mergeYAMLNodes - Merges the keys of a mapping into another, replacing everything that is not a mapping on both sides
+func mergeYAMLNodes(base, override *yaml.Node) {
+	if base.Kind != yaml.MappingNode || override.Kind != yaml.MappingNode {
+		*base = *override
+		return
+	}
+
+	for i := 0; i+1 < len(override.Content); i += 2 {
+		key, value := override.Content[i], override.Content[i+1]
+
+		found := false
+		for j := 0; j+1 < len(base.Content); j += 2 {
+			if base.Content[j].Value == key.Value {
+				mergeYAMLNodes(base.Content[j+1], value)
+				found = true
+				break
+			}
+		}
+		if !found {
+			base.Content = append(base.Content, key, value)
+		}
+	}
+}
+
+// GetWingsConfig - Returns the config.yml for a node with overrides merged over the configuration from the panel
+func (c *Client) GetWingsConfig(nodeID int32, overrides map[string]interface{}) ([]byte, error) {
+	config, err := c.GetNodeConfiguration(nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	var output bytes.Buffer
+	if err := WriteWingsConfig(&output, config, overrides); err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
+
+// WriteWingsConfigFile - Writes the config.yml of a node to a file, readable only by its owner as it holds the node token
+func WriteWingsConfigFile(path string, config NodeConfiguration, overrides map[string]interface{}) error {
+	var output bytes.Buffer
+	if err := WriteWingsConfig(&output, config, overrides); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, output.Bytes(), 0o600)
+}
@@ -2,10 +2,14 @@ package pterodactyl
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -17,6 +21,8 @@ type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Token      string
+	// ClientToken - Client API key used for /api/client endpoints, Token is used when empty
+	ClientToken string
 }
 
 // NewClient -
@@ -41,6 +47,16 @@ func NewClient(host, token *string) (*Client, error) {
 	return &c, nil
 }
 
+// StatusError - Returned when the panel answers with a status outside of 2xx
+type StatusError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+}
+
 func (c *Client) doRequest(req *http.Request, authToken *string) ([]byte, error) {
 	token := c.Token
 
@@ -65,13 +81,89 @@ func (c *Client) doRequest(req *http.Request, authToken *string) ([]byte, error)
 
 	statusOK := res.StatusCode >= 200 && res.StatusCode < 300
 	if !statusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		return nil, &StatusError{StatusCode: res.StatusCode, Body: body}
 	}
 
 	return body, nil
 }
 
+// clientToken - Token for requests to the client API
+func (c *Client) clientToken() *string {
+	if c.ClientToken == "" {
+		return nil
+	}
+	return &c.ClientToken
+}
+
 func (c *Client) prepareBody(body interface{}) io.Reader {
 	b, _ := json.Marshal(body)
 	return bytes.NewReader(b)
 }
+
+// includeQuery - Builds the query string requesting relationships to be included
+func includeQuery[T ~string](include []T) string {
+	if len(include) == 0 {
+		return ""
+	}
+
+	names := make([]string, len(include))
+	for i, relationship := range include {
+		names[i] = string(relationship)
+	}
+
+	return "?include=" + strings.Join(names, ",")
+}
+
+// getAllPages - Requests every page of a paginated list, handing each page body to handle
+func (c *Client) getAllPages(endpoint string, query url.Values, handle func(body []byte) (Pagination, error)) error {
+	if query == nil {
+		query = url.Values{}
+	}
+
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s%s?%s", c.HostURL, endpoint, query.Encode()), nil)
+		if err != nil {
+			return err
+		}
+
+		body, err := c.doRequest(req, nil)
+		if err != nil {
+			return err
+		}
+
+		pagination, err := handle(body)
+		if err != nil {
+			return err
+		}
+
+		if int32(page) >= pagination.TotalPages {
+			return nil
+		}
+	}
+}
+
+// Ptr - Returns a pointer to a value, used to set fields of the patch types
+func Ptr[T any](value T) *T {
+	return &value
+}
+
+// keep - Sets a patch field the caller left nil to the current value, the panel rejects updates missing required fields
+func keep[T any](field **T, current T) {
+	if *field == nil {
+		*field = &current
+	}
+}
+
+// sleepContext - Waits for a duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -1,6 +1,23 @@
 package pterodactyl
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
+
+// ListMeta - Metadata of a paginated list
+type ListMeta struct {
+	Pagination Pagination `json:"pagination"`
+}
+
+// Pagination -
+type Pagination struct {
+	Total       int32 `json:"total"`
+	Count       int32 `json:"count"`
+	PerPage     int32 `json:"per_page"`
+	CurrentPage int32 `json:"current_page"`
+	TotalPages  int32 `json:"total_pages"`
+}
 
 // User -
 type User struct {
@@ -16,8 +33,19 @@ type User struct {
 	Is2FA      bool      `json:"2fa"`
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
+	// Relationships - Only filled when requested with include
+	Relationships struct {
+		Servers ServersResponse `json:"servers"`
+	} `json:"relationships"`
 }
 
+// UserInclude - Relationship that can be included when requesting users
+type UserInclude string
+
+const (
+	UserIncludeServers UserInclude = "servers"
+)
+
 func (u User) GetEmail() string {
 	return u.Email
 }
@@ -30,14 +58,26 @@ func (u User) GetFirstName() string {
 func (u User) GetLastName() string {
 	return u.LastName
 }
+func (u User) GetExternalID() string {
+	return u.ExternalID
+}
+func (u User) GetLanguage() string {
+	return u.Language
+}
+func (u User) GetRootAdmin() bool {
+	return u.RootAdmin
+}
 
-// PartialUser - Only used for creating a new user
+// PartialUser - Only used for creating a new user, the panel generates a password when Password is empty
 type PartialUser struct {
-	Username  string `json:"username"`
-	Email     string `json:"email"`
-	FirstName string `json:"first_name"`
-	LastName  string `json:"last_name"`
-	// Language  string `json:"language"`
+	ExternalID string `json:"external_id,omitempty"`
+	Username   string `json:"username"`
+	Email      string `json:"email"`
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	Password   string `json:"password,omitempty"`
+	Language   string `json:"language,omitempty"`
+	RootAdmin  bool   `json:"root_admin"`
 }
 
 func (p PartialUser) GetEmail() string {
@@ -52,10 +92,47 @@ func (p PartialUser) GetFirstName() string {
 func (p PartialUser) GetLastName() string {
 	return p.LastName
 }
+func (p PartialUser) GetExternalID() string {
+	return p.ExternalID
+}
+func (p PartialUser) GetLanguage() string {
+	return p.Language
+}
+func (p PartialUser) GetRootAdmin() bool {
+	return p.RootAdmin
+}
+func (p PartialUser) GetPassword() string {
+	return p.Password
+}
+
+// UserPatch - Only used for partial user updates, nil fields are left unchanged
+type UserPatch struct {
+	ExternalID *string `json:"external_id,omitempty"`
+	Username   *string `json:"username,omitempty"`
+	Email      *string `json:"email,omitempty"`
+	FirstName  *string `json:"first_name,omitempty"`
+	LastName   *string `json:"last_name,omitempty"`
+	Password   *string `json:"password,omitempty"`
+	Language   *string `json:"language,omitempty"`
+	RootAdmin  *bool   `json:"root_admin,omitempty"`
+}
+
+// merge - Fills the fields left nil with the values of the current user, the password is only sent when set
+func (p UserPatch) merge(user User) UserPatch {
+	keep(&p.ExternalID, user.ExternalID)
+	keep(&p.Username, user.Username)
+	keep(&p.Email, user.Email)
+	keep(&p.FirstName, user.FirstName)
+	keep(&p.LastName, user.LastName)
+	keep(&p.Language, user.Language)
+	keep(&p.RootAdmin, user.RootAdmin)
+	return p
+}
 
 type UsersResponse struct {
 	Object string         `json:"object"`
 	Data   []UserResponse `json:"data"`
+	Meta   ListMeta       `json:"meta"`
 }
 type UserResponse struct {
 	Object     string `json:"object"`
@@ -72,28 +149,50 @@ type UserInterface interface {
 
 // Node -
 type Node struct {
-	ID                 int32     `json:"id"`
-	UUID               string    `json:"uuid"`
-	Public             bool      `json:"public"`
-	Name               string    `json:"name"`
-	Description        string    `json:"description"`
-	ContainerText      string    `json:"container_text"`
-	LocationID         int32     `json:"location_id"`
-	FQDN               string    `json:"fqdn"`
-	Scheme             string    `json:"scheme"`
-	BehindProxy        bool      `json:"behind_proxy"`
-	MaintenanceMode    bool      `json:"maintenance_mode"`
-	Memory             int32     `json:"memory"`
-	MemoryOverallocate int32     `json:"memory_overallocate"`
-	Disk               int32     `json:"disk"`
-	DiskOverallocate   int32     `json:"disk_overallocate"`
-	UploadSize         int32     `json:"upload_size"`
-	DaemonListen       int32     `json:"daemon_listen"`
-	DaemonText         string    `json:"daemon_text"`
-	DaemonSFTP         int32     `json:"daemon_sftp"`
-	DaemonBase         string    `json:"daemon_base"`
-	CreatedAt          time.Time `json:"created_at"`
-	UpdatedAt          time.Time `json:"updated_at"`
+	ID                 int32  `json:"id"`
+	UUID               string `json:"uuid"`
+	Public             bool   `json:"public"`
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	ContainerText      string `json:"container_text"`
+	LocationID         int32  `json:"location_id"`
+	FQDN               string `json:"fqdn"`
+	Scheme             string `json:"scheme"`
+	BehindProxy        bool   `json:"behind_proxy"`
+	MaintenanceMode    bool   `json:"maintenance_mode"`
+	Memory             int32  `json:"memory"`
+	MemoryOverallocate int32  `json:"memory_overallocate"`
+	Disk               int32  `json:"disk"`
+	DiskOverallocate   int32  `json:"disk_overallocate"`
+	UploadSize         int32  `json:"upload_size"`
+	DaemonListen       int32  `json:"daemon_listen"`
+	DaemonText         string `json:"daemon_text"`
+	DaemonSFTP         int32  `json:"daemon_sftp"`
+	DaemonBase         string `json:"daemon_base"`
+	// AllocatedResources - Memory and disk assigned to the servers of the node
+	AllocatedResources NodeResources `json:"allocated_resources"`
+	CreatedAt          time.Time     `json:"created_at"`
+	UpdatedAt          time.Time     `json:"updated_at"`
+	Relationships      struct {
+		Allocations AllocationsResponse `json:"allocations"`
+		Location    *LocationResponse   `json:"location,omitempty"`
+		Servers     ServersResponse     `json:"servers"`
+	} `json:"relationships"`
+}
+
+// NodeInclude - Relationship that can be included when requesting nodes
+type NodeInclude string
+
+const (
+	NodeIncludeAllocations NodeInclude = "allocations"
+	NodeIncludeLocation    NodeInclude = "location"
+	NodeIncludeServers     NodeInclude = "servers"
+)
+
+// NodeResources -
+type NodeResources struct {
+	Memory int64 `json:"memory"`
+	Disk   int64 `json:"disk"`
 }
 
 func (n Node) GetName() string {
@@ -221,9 +320,53 @@ func (n PartialNode) GetDaemonText() string {
 	return n.DaemonText
 }
 
+// NodePatch - Only used for partial node updates, nil fields are left unchanged
+type NodePatch struct {
+	Name               *string `json:"name,omitempty"`
+	Description        *string `json:"description,omitempty"`
+	ContainerText      *string `json:"container_text,omitempty"`
+	Public             *bool   `json:"public,omitempty"`
+	BehindProxy        *bool   `json:"behind_proxy,omitempty"`
+	MaintenanceMode    *bool   `json:"maintenance_mode,omitempty"`
+	LocationID         *int32  `json:"location_id,omitempty"`
+	FQDN               *string `json:"fqdn,omitempty"`
+	Scheme             *string `json:"scheme,omitempty"`
+	Memory             *int32  `json:"memory,omitempty"`
+	MemoryOverallocate *int32  `json:"memory_overallocate,omitempty"`
+	Disk               *int32  `json:"disk,omitempty"`
+	DiskOverallocate   *int32  `json:"disk_overallocate,omitempty"`
+	UploadSize         *int32  `json:"upload_size,omitempty"`
+	DaemonSFTP         *int32  `json:"daemon_sftp,omitempty"`
+	DaemonListen       *int32  `json:"daemon_listen,omitempty"`
+	DaemonText         *string `json:"daemon_text,omitempty"`
+}
+
+// merge - Fills the fields left nil with the values of the current node
+func (p NodePatch) merge(node Node) NodePatch {
+	keep(&p.Name, node.Name)
+	keep(&p.Description, node.Description)
+	keep(&p.ContainerText, node.ContainerText)
+	keep(&p.Public, node.Public)
+	keep(&p.BehindProxy, node.BehindProxy)
+	keep(&p.MaintenanceMode, node.MaintenanceMode)
+	keep(&p.LocationID, node.LocationID)
+	keep(&p.FQDN, node.FQDN)
+	keep(&p.Scheme, node.Scheme)
+	keep(&p.Memory, node.Memory)
+	keep(&p.MemoryOverallocate, node.MemoryOverallocate)
+	keep(&p.Disk, node.Disk)
+	keep(&p.DiskOverallocate, node.DiskOverallocate)
+	keep(&p.UploadSize, node.UploadSize)
+	keep(&p.DaemonSFTP, node.DaemonSFTP)
+	keep(&p.DaemonListen, node.DaemonListen)
+	keep(&p.DaemonText, node.DaemonText)
+	return p
+}
+
 type NodesResponse struct {
 	Object string         `json:"object"`
 	Data   []NodeResponse `json:"data"`
+	Meta   ListMeta       `json:"meta"`
 }
 
 type NodeResponse struct {
@@ -252,29 +395,173 @@ type NodesInterface interface {
 	GetDaemonText() string
 }
 
-// NodeConfiguration -
+// NodeConfiguration - Wings configuration of a node, fields the panel does not send are left to the Wings defaults
 type NodeConfiguration struct {
-	Debug   bool   `json:"debug"`
-	UUID    string `json:"uuid"`
-	TokenID string `json:"token_id"`
-	Token   string `json:"token"`
-	API     struct {
-		Host string `json:"host"`
-		Port int32  `json:"port"`
-		SSL  struct {
-			Enabled bool   `json:"enabled"`
-			Cert    string `json:"cert"`
-			Key     string `json:"key"`
-		} `json:"ssl"`
-		UploadLimit int32 `json:"upload_limit"`
-	} `json:"api"`
-	System struct {
-		Data string `json:"data"`
-		SFTP struct {
-			BindPort int32 `json:"bind_port"`
-		} `json:"sftp"`
-	} `json:"system"`
-	Remote string `json:"remote"`
+	Debug                    bool                          `json:"debug" yaml:"debug"`
+	AppName                  string                        `json:"app_name,omitempty" yaml:"app_name,omitempty"`
+	UUID                     string                        `json:"uuid" yaml:"uuid"`
+	TokenID                  string                        `json:"token_id" yaml:"token_id"`
+	Token                    string                        `json:"token" yaml:"token"`
+	API                      WingsAPIConfiguration         `json:"api" yaml:"api"`
+	System                   WingsSystemConfiguration      `json:"system" yaml:"system"`
+	Docker                   WingsDockerConfiguration      `json:"docker" yaml:"docker,omitempty"`
+	Throttles                WingsThrottlesConfiguration   `json:"throttles" yaml:"throttles,omitempty"`
+	Remote                   string                        `json:"remote" yaml:"remote"`
+	RemoteQuery              WingsRemoteQueryConfiguration `json:"remote_query" yaml:"remote_query,omitempty"`
+	AllowedMounts            []string                      `json:"allowed_mounts" yaml:"allowed_mounts"`
+	AllowedOrigins           []string                      `json:"allowed_origins" yaml:"allowed_origins"`
+	AllowCORSPrivateNetwork  bool                          `json:"allow_cors_private_network,omitempty" yaml:"allow_cors_private_network,omitempty"`
+	IgnorePanelConfigUpdates bool                          `json:"ignore_panel_config_updates,omitempty" yaml:"ignore_panel_config_updates,omitempty"`
+}
+
+// WingsAPIConfiguration -
+type WingsAPIConfiguration struct {
+	Host                  string                `json:"host" yaml:"host"`
+	Port                  int32                 `json:"port" yaml:"port"`
+	SSL                   WingsSSLConfiguration `json:"ssl" yaml:"ssl"`
+	DisableRemoteDownload bool                  `json:"disable_remote_download,omitempty" yaml:"disable_remote_download,omitempty"`
+	UploadLimit           int32                 `json:"upload_limit" yaml:"upload_limit"`
+	TrustedProxies        []string              `json:"trusted_proxies,omitempty" yaml:"trusted_proxies,omitempty"`
+}
+
+// WingsSSLConfiguration -
+type WingsSSLConfiguration struct {
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+	Cert    string `json:"cert" yaml:"cert"`
+	Key     string `json:"key" yaml:"key"`
+}
+
+// WingsSystemConfiguration -
+type WingsSystemConfiguration struct {
+	RootDirectory          string                           `json:"root_directory,omitempty" yaml:"root_directory,omitempty"`
+	LogDirectory           string                           `json:"log_directory,omitempty" yaml:"log_directory,omitempty"`
+	Data                   string                           `json:"data" yaml:"data"`
+	ArchiveDirectory       string                           `json:"archive_directory,omitempty" yaml:"archive_directory,omitempty"`
+	BackupDirectory        string                           `json:"backup_directory,omitempty" yaml:"backup_directory,omitempty"`
+	TmpDirectory           string                           `json:"tmp_directory,omitempty" yaml:"tmp_directory,omitempty"`
+	Username               string                           `json:"username,omitempty" yaml:"username,omitempty"`
+	Timezone               string                           `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	User                   WingsUserConfiguration           `json:"user" yaml:"user,omitempty"`
+	DiskCheckInterval      int64                            `json:"disk_check_interval,omitempty" yaml:"disk_check_interval,omitempty"`
+	ActivitySendInterval   int64                            `json:"activity_send_interval,omitempty" yaml:"activity_send_interval,omitempty"`
+	ActivitySendCount      int64                            `json:"activity_send_count,omitempty" yaml:"activity_send_count,omitempty"`
+	CheckPermissionsOnBoot *bool                            `json:"check_permissions_on_boot,omitempty" yaml:"check_permissions_on_boot,omitempty"`
+	EnableLogRotate        *bool                            `json:"enable_log_rotate,omitempty" yaml:"enable_log_rotate,omitempty"`
+	WebsocketLogCount      int32                            `json:"websocket_log_count,omitempty" yaml:"websocket_log_count,omitempty"`
+	SFTP                   WingsSFTPConfiguration           `json:"sftp" yaml:"sftp"`
+	CrashDetection         WingsCrashDetectionConfiguration `json:"crash_detection" yaml:"crash_detection,omitempty"`
+	Backups                WingsBackupsConfiguration        `json:"backups" yaml:"backups,omitempty"`
+	Transfers              WingsTransfersConfiguration      `json:"transfers" yaml:"transfers,omitempty"`
+	OpenatMode             string                           `json:"openat_mode,omitempty" yaml:"openat_mode,omitempty"`
+}
+
+// WingsUserConfiguration - System user Wings runs containers as
+type WingsUserConfiguration struct {
+	UID int32 `json:"uid,omitempty" yaml:"uid,omitempty"`
+	GID int32 `json:"gid,omitempty" yaml:"gid,omitempty"`
+}
+
+// WingsSFTPConfiguration -
+type WingsSFTPConfiguration struct {
+	BindAddress string `json:"bind_address,omitempty" yaml:"bind_address,omitempty"`
+	BindPort    int32  `json:"bind_port" yaml:"bind_port"`
+	ReadOnly    bool   `json:"read_only,omitempty" yaml:"read_only,omitempty"`
+}
+
+// WingsCrashDetectionConfiguration -
+type WingsCrashDetectionConfiguration struct {
+	Enabled                *bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	DetectCleanExitAsCrash *bool `json:"detect_clean_exit_as_crash,omitempty" yaml:"detect_clean_exit_as_crash,omitempty"`
+	Timeout                int32 `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+}
+
+// WingsBackupsConfiguration -
+type WingsBackupsConfiguration struct {
+	WriteLimit       int32  `json:"write_limit,omitempty" yaml:"write_limit,omitempty"`
+	CompressionLevel string `json:"compression_level,omitempty" yaml:"compression_level,omitempty"`
+}
+
+// WingsTransfersConfiguration -
+type WingsTransfersConfiguration struct {
+	DownloadLimit int32 `json:"download_limit,omitempty" yaml:"download_limit,omitempty"`
+}
+
+// WingsDockerConfiguration -
+type WingsDockerConfiguration struct {
+	Network              WingsDockerNetworkConfiguration       `json:"network" yaml:"network,omitempty"`
+	Domainname           string                                `json:"domainname,omitempty" yaml:"domainname,omitempty"`
+	Registries           map[string]WingsRegistryConfiguration `json:"registries,omitempty" yaml:"registries,omitempty"`
+	TmpfsSize            int32                                 `json:"tmpfs_size,omitempty" yaml:"tmpfs_size,omitempty"`
+	ContainerPIDLimit    int32                                 `json:"container_pid_limit,omitempty" yaml:"container_pid_limit,omitempty"`
+	InstallerLimits      WingsInstallerLimitsConfiguration     `json:"installer_limits" yaml:"installer_limits,omitempty"`
+	Overhead             WingsOverheadConfiguration            `json:"overhead" yaml:"overhead,omitempty"`
+	UsePerformantInspect *bool                                 `json:"use_performant_inspect,omitempty" yaml:"use_performant_inspect,omitempty"`
+	UsernsMode           string                                `json:"userns_mode,omitempty" yaml:"userns_mode,omitempty"`
+	LogConfig            WingsDockerLogConfiguration           `json:"log_config" yaml:"log_config,omitempty"`
+}
+
+// WingsDockerNetworkConfiguration -
+type WingsDockerNetworkConfiguration struct {
+	Interface  string                             `json:"interface,omitempty" yaml:"interface,omitempty"`
+	DNS        []string                           `json:"dns,omitempty" yaml:"dns,omitempty"`
+	Name       string                             `json:"name,omitempty" yaml:"name,omitempty"`
+	ISPN       bool                               `json:"ispn,omitempty" yaml:"ispn,omitempty"`
+	Driver     string                             `json:"driver,omitempty" yaml:"driver,omitempty"`
+	Mode       string                             `json:"network_mode,omitempty" yaml:"network_mode,omitempty"`
+	IsInternal bool                               `json:"is_internal,omitempty" yaml:"is_internal,omitempty"`
+	EnableICC  *bool                              `json:"enable_icc,omitempty" yaml:"enable_icc,omitempty"`
+	NetworkMTU int32                              `json:"network_mtu,omitempty" yaml:"network_mtu,omitempty"`
+	Interfaces WingsDockerInterfacesConfiguration `json:"interfaces" yaml:"interfaces,omitempty"`
+}
+
+// WingsDockerInterfacesConfiguration -
+type WingsDockerInterfacesConfiguration struct {
+	V4 WingsDockerSubnetConfiguration `json:"v4" yaml:"v4,omitempty"`
+	V6 WingsDockerSubnetConfiguration `json:"v6" yaml:"v6,omitempty"`
+}
+
+// WingsDockerSubnetConfiguration -
+type WingsDockerSubnetConfiguration struct {
+	Subnet  string `json:"subnet,omitempty" yaml:"subnet,omitempty"`
+	Gateway string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+}
+
+// WingsRegistryConfiguration - Credentials for a docker registry
+type WingsRegistryConfiguration struct {
+	Username string `json:"username" yaml:"username"`
+	Password string `json:"password" yaml:"password"`
+}
+
+// WingsInstallerLimitsConfiguration -
+type WingsInstallerLimitsConfiguration struct {
+	Memory int32 `json:"memory,omitempty" yaml:"memory,omitempty"`
+	CPU    int32 `json:"cpu,omitempty" yaml:"cpu,omitempty"`
+}
+
+// WingsOverheadConfiguration - Memory overhead added to the containers, multipliers are keyed by memory limit
+type WingsOverheadConfiguration struct {
+	Override          bool              `json:"override,omitempty" yaml:"override,omitempty"`
+	DefaultMultiplier float64           `json:"default_multiplier,omitempty" yaml:"default_multiplier,omitempty"`
+	Multipliers       map[int32]float64 `json:"multipliers,omitempty" yaml:"multipliers,omitempty"`
+}
+
+// WingsDockerLogConfiguration -
+type WingsDockerLogConfiguration struct {
+	Type   string            `json:"type,omitempty" yaml:"type,omitempty"`
+	Config map[string]string `json:"config,omitempty" yaml:"config,omitempty"`
+}
+
+// WingsThrottlesConfiguration - Console output throttling
+type WingsThrottlesConfiguration struct {
+	Enabled           *bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Lines             int32 `json:"lines,omitempty" yaml:"lines,omitempty"`
+	LineResetInterval int32 `json:"line_reset_interval,omitempty" yaml:"line_reset_interval,omitempty"`
+}
+
+// WingsRemoteQueryConfiguration -
+type WingsRemoteQueryConfiguration struct {
+	Timeout            int32 `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	BootServersPerPage int32 `json:"boot_servers_per_page,omitempty" yaml:"boot_servers_per_page,omitempty"`
 }
 
 type NodeConfigurationResponse struct {
@@ -292,14 +579,17 @@ type Allocation struct {
 	Assigned bool   `json:"assigned"`
 }
 
+// PartialAllocation - Only used for creating allocations, Ports holds single ports ("25565") or ranges ("25565-25600")
 type PartialAllocation struct {
 	IP    string   `json:"ip"`
+	Alias string   `json:"alias,omitempty"`
 	Ports []string `json:"ports"`
 }
 
 type AllocationsResponse struct {
 	Object string               `json:"object"`
 	Data   []AllocationResponse `json:"data"`
+	Meta   ListMeta             `json:"meta"`
 }
 
 type AllocationResponse struct {
@@ -314,8 +604,21 @@ type Location struct {
 	Long      string    `json:"long"`
 	UpdatedAt time.Time `json:"updated_at"`
 	CreatedAt time.Time `json:"created_at"`
+	// Relationships - Only filled when requested with include
+	Relationships struct {
+		Nodes   NodesResponse   `json:"nodes"`
+		Servers ServersResponse `json:"servers"`
+	} `json:"relationships"`
 }
 
+// LocationInclude - Relationship that can be included when requesting locations
+type LocationInclude string
+
+const (
+	LocationIncludeNodes   LocationInclude = "nodes"
+	LocationIncludeServers LocationInclude = "servers"
+)
+
 func (l Location) GetShort() string {
 	return l.Short
 }
@@ -335,9 +638,23 @@ func (l PartialLocation) GetLong() string {
 	return l.Long
 }
 
+// LocationPatch - Only used for partial location updates, nil fields are left unchanged
+type LocationPatch struct {
+	Short *string `json:"short,omitempty"`
+	Long  *string `json:"long,omitempty"`
+}
+
+// merge - Fills the fields left nil with the values of the current location
+func (p LocationPatch) merge(location Location) LocationPatch {
+	keep(&p.Short, location.Short)
+	keep(&p.Long, location.Long)
+	return p
+}
+
 type LocationsResponse struct {
 	Object string             `json:"object"`
 	Data   []LocationResponse `json:"data"`
+	Meta   ListMeta           `json:"meta"`
 }
 
 type LocationResponse struct {
@@ -371,11 +688,28 @@ type ServerDatabase struct {
 		} `json:"password"`
 		Host struct {
 			Object     string `json:"object"`
-			Attributes []Host `json:"attributes"`
+			Attributes Host   `json:"attributes"`
 		} `json:"host"`
 	} `json:"relationships"`
 }
 
+// PartialServerDatabase - Only used for creating a new server database
+type PartialServerDatabase struct {
+	Database string `json:"database"`
+	Remote   string `json:"remote"`
+	Host     int32  `json:"host"`
+}
+
+type ServerDatabasesResponse struct {
+	Object string                   `json:"object"`
+	Data   []ServerDatabaseResponse `json:"data"`
+}
+
+type ServerDatabaseResponse struct {
+	Object     string         `json:"object"`
+	Attributes ServerDatabase `json:"attributes"`
+}
+
 // Host -
 type Host struct {
 	ID        int32     `json:"id"`
@@ -396,6 +730,7 @@ type Server struct {
 	Identifier    string    `json:"identifier"`
 	Name          string    `json:"name"`
 	Description   string    `json:"description"`
+	Status        string    `json:"status"`
 	Suspended     bool      `json:"suspended"`
 	Limits        Limits    `json:"limits"`
 	FeatureLimit  Feature   `json:"feature_limits"`
@@ -409,13 +744,137 @@ type Server struct {
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
 	Relationships struct {
-		Databases struct {
-			Object string           `json:"object"`
-			Data   []ServerDatabase `json:"data"`
-		} `json:"databases"`
+		Allocations AllocationsResponse     `json:"allocations"`
+		Databases   ServerDatabasesResponse `json:"databases"`
 	} `json:"relationships"`
 }
 
+// Subuser - User with access to a server they do not own, returned by the client API
+type Subuser struct {
+	UUID             string    `json:"uuid"`
+	Username         string    `json:"username"`
+	Email            string    `json:"email"`
+	Image            string    `json:"image"`
+	TwoFactorEnabled bool      `json:"2fa_enabled"`
+	CreatedAt        time.Time `json:"created_at"`
+	Permissions      []string  `json:"permissions"`
+}
+
+type SubusersResponse struct {
+	Object string            `json:"object"`
+	Data   []SubuserResponse `json:"data"`
+}
+type SubuserResponse struct {
+	Object     string  `json:"object"`
+	Attributes Subuser `json:"attributes"`
+}
+
+// ServerDetails - Only used for updating the details of a server
+type ServerDetails struct {
+	ExternalID  string `json:"external_id,omitempty"`
+	Name        string `json:"name"`
+	User        int32  `json:"user"`
+	Description string `json:"description"`
+}
+
+// ServerInclude - Relationship that can be included when requesting servers
+type ServerInclude string
+
+const (
+	ServerIncludeAllocations ServerInclude = "allocations"
+	ServerIncludeDatabases   ServerInclude = "databases"
+)
+
+// Server statuses reported by the panel, a server without status is ready
+const (
+	ServerStatusInstalling      = "installing"
+	ServerStatusInstallFailed   = "install_failed"
+	ServerStatusReinstallFailed = "reinstall_failed"
+	ServerStatusSuspended       = "suspended"
+	ServerStatusRestoringBackup = "restoring_backup"
+)
+
+// Power signals of the client API
+const (
+	PowerSignalStart   = "start"
+	PowerSignalStop    = "stop"
+	PowerSignalRestart = "restart"
+	PowerSignalKill    = "kill"
+)
+
+// Power states reported by the client API
+const (
+	PowerStateOffline  = "offline"
+	PowerStateStarting = "starting"
+	PowerStateRunning  = "running"
+	PowerStateStopping = "stopping"
+)
+
+// ServerResources - Current state and resource usage of a server from the client API
+type ServerResources struct {
+	CurrentState string `json:"current_state"`
+	IsSuspended  bool   `json:"is_suspended"`
+	Resources    struct {
+		MemoryBytes    int64   `json:"memory_bytes"`
+		CPUAbsolute    float64 `json:"cpu_absolute"`
+		DiskBytes      int64   `json:"disk_bytes"`
+		NetworkRxBytes int64   `json:"network_rx_bytes"`
+		NetworkTxBytes int64   `json:"network_tx_bytes"`
+		Uptime         int64   `json:"uptime"`
+	} `json:"resources"`
+}
+
+type ServerResourcesResponse struct {
+	Object     string          `json:"object"`
+	Attributes ServerResources `json:"attributes"`
+}
+
+// ServerAllocation - Allocations assigned to a server on creation
+type ServerAllocation struct {
+	Default    int32   `json:"default"`
+	Additional []int32 `json:"additional,omitempty"`
+}
+
+// PartialServer - Only used for creating a new server
+type PartialServer struct {
+	ExternalID        string           `json:"external_id,omitempty"`
+	Name              string           `json:"name"`
+	Description       string           `json:"description,omitempty"`
+	User              int32            `json:"user"`
+	Egg               int32            `json:"egg"`
+	DockerImage       string           `json:"docker_image"`
+	Startup           string           `json:"startup"`
+	Environment       Environment      `json:"environment"`
+	Limits            Limits           `json:"limits"`
+	FeatureLimits     Feature          `json:"feature_limits"`
+	Allocation        ServerAllocation `json:"allocation"`
+	StartOnCompletion bool             `json:"start_on_completion"`
+	SkipScripts       bool             `json:"skip_scripts"`
+	OOMDisabled       bool             `json:"oom_disabled"`
+	// Variables - Variables of the egg, when set the environment is validated against them before creating the server
+	Variables []EggVariable `json:"-"`
+}
+
+// ServerStartup - Only used for updating the startup of a server
+type ServerStartup struct {
+	Startup     string      `json:"startup"`
+	Environment Environment `json:"environment"`
+	Egg         int32       `json:"egg"`
+	Image       string      `json:"image"`
+	SkipScripts bool        `json:"skip_scripts"`
+}
+
+type ServersResponse struct {
+	Object string           `json:"object"`
+	Data   []ServerResponse `json:"data"`
+	Meta   ListMeta         `json:"meta"`
+}
+
+type ServerResponse struct {
+	Object     string `json:"object"`
+	Attributes Server `json:"attributes"`
+}
+
 // Limits -
 type Limits struct {
 	Memory  int32 `json:"memory"`
@@ -435,68 +894,123 @@ type Feature struct {
 
 // Container -
 type Container struct {
-	StartupCommand string `json:"startup_command"`
-	Image          string `json:"image"`
-	Installed      bool   `json:"installed"`
-	Environment    struct {
-		ServerJarfile string `json:"SERVER_JARFILE"`
-		VanillaVer    string `json:"VANILLA_VERSION"`
-		Startup       string `json:"STARTUP"`
-		PServerLoc    string `json:"P_SERVER_LOCATION"`
-		PServerUUID   string `json:"P_SERVER_UUID"`
-	} `json:"environment"`
+	StartupCommand string      `json:"startup_command"`
+	Image          string      `json:"image"`
+	Installed      Flag        `json:"installed"`
+	Environment    Environment `json:"environment"`
+}
+
+// Flag - Boolean the panel may send as either true/false or 1/0
+type Flag bool
+
+func (f *Flag) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "true", "1":
+		*f = true
+	case "false", "0", "null":
+		*f = false
+	default:
+		return fmt.Errorf("invalid boolean value: %s", data)
+	}
+	return nil
 }
 
 // Egg -
 type Egg struct {
+	ID          int32  `json:"id"`
+	UUID        string `json:"uuid"`
+	Name        string `json:"name"`
+	Nest        int32  `json:"nest"`
+	Author      string `json:"author"`
+	Description string `json:"description"`
+	DockerImage string `json:"docker_image"`
+	// DockerImages - Images offered by the egg keyed by label, DockerImage is the first of them
+	DockerImages  ImageCatalog     `json:"docker_images"`
+	Config        EggConfig        `json:"config"`
+	Startup       string           `json:"startup"`
+	Script        EggScript        `json:"script"`
+	CreatedAt     time.Time        `json:"created_at"`
+	UpdatedAt     time.Time        `json:"updated_at"`
+	Relationships EggRelationships `json:"relationships"`
+}
+
+// EggRelationships - Resources included with an egg, only filled when requested through an EggInclude
+type EggRelationships struct {
+	Nest    *NestResponse   `json:"nest,omitempty"`
+	Servers ServersResponse `json:"servers"`
+	// Config - Egg the configuration is copied from
+	Config *EggResponse `json:"config,omitempty"`
+	// Script - Egg the install script is copied from
+	Script    *EggResponse         `json:"script,omitempty"`
+	Variables EggVariablesResponse `json:"variables"`
+}
+
+// EggInclude - Relationship that can be included when requesting eggs
+type EggInclude string
+
+const (
+	EggIncludeNest      EggInclude = "nest"
+	EggIncludeServers   EggInclude = "servers"
+	EggIncludeConfig    EggInclude = "config"
+	EggIncludeScript    EggInclude = "script"
+	EggIncludeVariables EggInclude = "variables"
+)
+
+type EggsResponse struct {
+	Object string        `json:"object"`
+	Data   []EggResponse `json:"data"`
+}
+
+type EggResponse struct {
 	Object     string `json:"object"`
-	Attributes struct {
-		ID            int32     `json:"id"`
-		UUID          string    `json:"uuid"`
-		Name          string    `json:"name"`
-		Nest          int32     `json:"nest"`
-		Author        string    `json:"author"`
-		Description   string    `json:"description"`
-		DockerImage   string    `json:"docker_image"`
-		Config        EggConfig `json:"config"`
-		Startup       string    `json:"startup"`
-		Script        EggScript `json:"script"`
-		CreatedAt     time.Time `json:"created_at"`
-		UpdatedAt     time.Time `json:"updated_at"`
-		Relationships struct {
-			Nest struct {
-				Object     string `json:"object"`
-				Attributes struct {
-					ID          int32     `json:"id"`
-					UUID        string    `json:"uuid"`
-					Author      string    `json:"author"`
-					Name        string    `json:"name"`
-					Description string    `json:"description"`
-					CreatedAt   time.Time `json:"created_at"`
-					UpdatedAt   time.Time `json:"updated_at"`
-				} `json:"attributes"`
-			} `json:"nest"`
-			Servers struct {
-				Object string        `json:"object"`
-				Data   []interface{} `json:"data"`
-			} `json:"servers"`
-		} `json:"relationships"`
-	} `json:"attributes"`
+	Attributes Egg    `json:"attributes"`
+}
+
+// EggVariable - Environment variable defined by an egg
+type EggVariable struct {
+	ID           int32     `json:"id"`
+	EggID        int32     `json:"egg_id"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	EnvVariable  string    `json:"env_variable"`
+	DefaultValue string    `json:"default_value"`
+	UserViewable bool      `json:"user_viewable"`
+	UserEditable bool      `json:"user_editable"`
+	Rules        string    `json:"rules"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type EggVariablesResponse struct {
+	Object string                `json:"object"`
+	Data   []EggVariableResponse `json:"data"`
+}
+
+type EggVariableResponse struct {
+	Object     string      `json:"object"`
+	Attributes EggVariable `json:"attributes"`
 }
 
 // EggConfig -
 type EggConfig struct {
-	Files   map[string]EggConfigFile `json:"files"`
-	Startup struct {
-		Done            string   `json:"done"`
-		UserInteraction []string `json:"userInteraction"`
-	} `json:"startup"`
-	Stop string `json:"stop"`
-	Logs struct {
-		Custom   bool   `json:"custom"`
-		Location string `json:"location"`
-	} `json:"logs"`
-	Extends interface{} `json:"extends"`
+	Files        map[string]EggConfigFile `json:"files"`
+	Startup      EggConfigStartup         `json:"startup"`
+	Stop         string                   `json:"stop"`
+	Logs         EggConfigLogs            `json:"logs"`
+	FileDenylist []string                 `json:"file_denylist"`
+	Extends      interface{}              `json:"extends"`
+}
+
+// EggConfigStartup -
+type EggConfigStartup struct {
+	Done            string   `json:"done"`
+	UserInteraction []string `json:"userInteraction"`
+}
+
+// EggConfigLogs -
+type EggConfigLogs struct {
+	Custom   bool   `json:"custom"`
+	Location string `json:"location"`
 }
 
 // EggConfigFile -
@@ -524,3 +1038,14 @@ type Nest struct {
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
+
+type NestsResponse struct {
+	Object string         `json:"object"`
+	Data   []NestResponse `json:"data"`
+	Meta   ListMeta       `json:"meta"`
+}
+
+type NestResponse struct {
+	Object     string `json:"object"`
+	Attributes Nest   `json:"attributes"`
+}
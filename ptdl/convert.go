@@ -0,0 +1,167 @@
+package ptdl
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	pterodactyl "github.com/Lela810/pterodactyl-client-go"
+)
+
+// Egg - Converts the file into an egg, its variables are available through Egg.Variables
+func (f *File) Egg() (pterodactyl.Egg, error) {
+	config, err := f.eggConfig()
+	if err != nil {
+		return pterodactyl.Egg{}, err
+	}
+
+	egg := pterodactyl.Egg{
+		Name:         f.Name,
+		Author:       f.Author,
+		Description:  f.Description,
+		DockerImage:  firstImage(f.images()),
+		DockerImages: f.dockerImages(),
+		Config:       config,
+		Startup:      f.Startup,
+		Script: pterodactyl.EggScript{
+			Install:   f.Scripts.Installation.Script,
+			Container: f.Scripts.Installation.Container,
+			Entry:     f.Scripts.Installation.Entrypoint,
+		},
+	}
+
+	variables := make([]pterodactyl.EggVariableResponse, len(f.Variables))
+	for i, variable := range f.Variables {
+		variables[i] = pterodactyl.EggVariableResponse{
+			Object: "egg_variable",
+			Attributes: pterodactyl.EggVariable{
+				Name:         variable.Name,
+				Description:  variable.Description,
+				EnvVariable:  variable.EnvVariable,
+				DefaultValue: variable.DefaultValue,
+				UserViewable: bool(variable.UserViewable),
+				UserEditable: bool(variable.UserEditable),
+				Rules:        variable.Rules,
+			},
+		}
+	}
+	egg.Relationships.Variables = pterodactyl.EggVariablesResponse{Object: "list", Data: variables}
+
+	return egg, nil
+}
+
+// FromEgg - Builds an export file from an egg, which must be fetched with pterodactyl.EggIncludeVariables to export its variables
+func FromEgg(egg pterodactyl.Egg, version string) (*File, error) {
+	config, err := exportConfig(egg.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &File{
+		Comment:      Comment,
+		Meta:         Meta{Version: VersionV2},
+		ExportedAt:   time.Now().Format(time.RFC3339),
+		Name:         egg.Name,
+		Author:       egg.Author,
+		Description:  egg.Description,
+		Features:     []string{},
+		FileDenylist: egg.Config.FileDenylist,
+		Startup:      egg.Startup,
+		Config:       config,
+		Scripts: Scripts{Installation: Installation{
+			Script:     egg.Script.Install,
+			Container:  egg.Script.Container,
+			Entrypoint: egg.Script.Entry,
+		}},
+	}
+	if file.FileDenylist == nil {
+		file.FileDenylist = []string{}
+	}
+	if images := egg.Images(); len(images) > 0 {
+		file.DockerImages = images
+	}
+
+	for _, variable := range egg.Variables() {
+		file.Variables = append(file.Variables, Variable{
+			Name:         variable.Name,
+			Description:  variable.Description,
+			EnvVariable:  variable.EnvVariable,
+			DefaultValue: variable.DefaultValue,
+			UserViewable: pterodactyl.Flag(variable.UserViewable),
+			UserEditable: pterodactyl.Flag(variable.UserEditable),
+			Rules:        variable.Rules,
+			FieldType:    "text",
+		})
+	}
+	if file.Variables == nil {
+		file.Variables = []Variable{}
+	}
+
+	return file.Convert(version)
+}
+
+func (f *File) eggConfig() (pterodactyl.EggConfig, error) {
+	raw := map[string]interface{}{
+		"stop":          f.Config.Stop,
+		"file_denylist": f.FileDenylist,
+	}
+	for key, section := range map[string]string{
+		"files":   f.Config.Files,
+		"startup": f.Config.Startup,
+		"logs":    f.Config.Logs,
+	} {
+		if section == "" {
+			continue
+		}
+		if !json.Valid([]byte(section)) {
+			return pterodactyl.EggConfig{}, fmt.Errorf("config %s is not valid JSON", key)
+		}
+		raw[key] = json.RawMessage(section)
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return pterodactyl.EggConfig{}, err
+	}
+
+	var config pterodactyl.EggConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return pterodactyl.EggConfig{}, err
+	}
+
+	return config, nil
+}
+
+func exportConfig(config pterodactyl.EggConfig) (Config, error) {
+	files := config.Files
+	if files == nil {
+		files = map[string]pterodactyl.EggConfigFile{}
+	}
+
+	encodedFiles, err := json.Marshal(files)
+	if err != nil {
+		return Config{}, err
+	}
+	encodedStartup, err := json.Marshal(config.Startup)
+	if err != nil {
+		return Config{}, err
+	}
+	encodedLogs, err := json.Marshal(config.Logs)
+	if err != nil {
+		return Config{}, err
+	}
+
+	return Config{
+		Files:   string(encodedFiles),
+		Startup: string(encodedStartup),
+		Logs:    string(encodedLogs),
+		Stop:    config.Stop,
+	}, nil
+}
+
+func firstImage(images []string) string {
+	if len(images) == 0 {
+		return ""
+	}
+	return images[0]
+}
@@ -0,0 +1,104 @@
+package ptdl
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	pterodactyl "github.com/Lela810/pterodactyl-client-go"
+)
+
+// Difference - Field whose value in an export file differs from the egg on the panel
+type Difference struct {
+	Field string
+	File  string
+	Panel string
+}
+
+// Diff - Compares an export file against an egg fetched from the panel with pterodactyl.EggIncludeVariables
+func Diff(file *File, egg pterodactyl.Egg) ([]Difference, error) {
+	fileEgg, err := file.Egg()
+	if err != nil {
+		return nil, err
+	}
+
+	var differences []Difference
+	compare := func(field, fileValue, panelValue string) {
+		if fileValue != panelValue {
+			differences = append(differences, Difference{Field: field, File: fileValue, Panel: panelValue})
+		}
+	}
+	compareJSON := func(field string, fileValue, panelValue interface{}) error {
+		encodedFile, err := json.Marshal(fileValue)
+		if err != nil {
+			return err
+		}
+		encodedPanel, err := json.Marshal(panelValue)
+		if err != nil {
+			return err
+		}
+		compare(field, string(encodedFile), string(encodedPanel))
+		return nil
+	}
+
+	compare("name", fileEgg.Name, egg.Name)
+	compare("author", fileEgg.Author, egg.Author)
+	compare("description", fileEgg.Description, egg.Description)
+	if err := compareJSON("docker_images", fileEgg.Images(), egg.Images()); err != nil {
+		return nil, err
+	}
+	compare("startup", fileEgg.Startup, egg.Startup)
+	compare("config.stop", fileEgg.Config.Stop, egg.Config.Stop)
+	compare("file_denylist", strings.Join(fileEgg.Config.FileDenylist, "\n"), strings.Join(egg.Config.FileDenylist, "\n"))
+	if err := compareJSON("config.files", nonNilFiles(fileEgg.Config.Files), nonNilFiles(egg.Config.Files)); err != nil {
+		return nil, err
+	}
+	if err := compareJSON("config.startup", fileEgg.Config.Startup, egg.Config.Startup); err != nil {
+		return nil, err
+	}
+	if err := compareJSON("config.logs", fileEgg.Config.Logs, egg.Config.Logs); err != nil {
+		return nil, err
+	}
+	compare("scripts.installation.script", normalizeScript(fileEgg.Script.Install), normalizeScript(egg.Script.Install))
+	compare("scripts.installation.container", fileEgg.Script.Container, egg.Script.Container)
+	compare("scripts.installation.entrypoint", fileEgg.Script.Entry, egg.Script.Entry)
+
+	panelVariables := map[string]pterodactyl.EggVariable{}
+	for _, variable := range egg.Variables() {
+		panelVariables[variable.EnvVariable] = variable
+	}
+	for _, fileVariable := range fileEgg.Variables() {
+		field := "variables." + fileVariable.EnvVariable
+		panelVariable, ok := panelVariables[fileVariable.EnvVariable]
+		if !ok {
+			compare(field, fileVariable.Name, "")
+			continue
+		}
+		delete(panelVariables, fileVariable.EnvVariable)
+
+		compare(field+".name", fileVariable.Name, panelVariable.Name)
+		compare(field+".description", fileVariable.Description, panelVariable.Description)
+		compare(field+".default_value", fileVariable.DefaultValue, panelVariable.DefaultValue)
+		compare(field+".user_viewable", strconv.FormatBool(fileVariable.UserViewable), strconv.FormatBool(panelVariable.UserViewable))
+		compare(field+".user_editable", strconv.FormatBool(fileVariable.UserEditable), strconv.FormatBool(panelVariable.UserEditable))
+		compare(field+".rules", fileVariable.Rules, panelVariable.Rules)
+	}
+	for _, panelVariable := range egg.Variables() {
+		if _, ok := panelVariables[panelVariable.EnvVariable]; ok {
+			compare("variables."+panelVariable.EnvVariable, "", panelVariable.Name)
+		}
+	}
+
+	return differences, nil
+}
+
+func nonNilFiles(files map[string]pterodactyl.EggConfigFile) map[string]pterodactyl.EggConfigFile {
+	if files == nil {
+		return map[string]pterodactyl.EggConfigFile{}
+	}
+	return files
+}
+
+func normalizeScript(script string) string {
+	return strings.ReplaceAll(script, "\r\n", "\n")
+}
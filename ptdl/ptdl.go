@@ -0,0 +1,198 @@
+// Package ptdl reads and writes the egg files exported by the panel (PTDL_v1 and PTDL_v2)
+package ptdl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	pterodactyl "github.com/Lela810/pterodactyl-client-go"
+)
+
+// Supported export format versions
+const (
+	VersionV1 = "PTDL_v1"
+	VersionV2 = "PTDL_v2"
+)
+
+// Comment - Comment the panel places at the top of exported files
+const Comment = "DO NOT EDIT: FILE GENERATED AUTOMATICALLY BY PTERODACTYL PANEL - PTERODACTYL.IO"
+
+// File - Egg export file
+type File struct {
+	Comment     string   `json:"_comment,omitempty"`
+	Meta        Meta     `json:"meta"`
+	ExportedAt  string   `json:"exported_at"`
+	Name        string   `json:"name"`
+	Author      string   `json:"author"`
+	Description string   `json:"description"`
+	Features    []string `json:"features"`
+	// DockerImages - Images keyed by label, only used by PTDL_v2
+	DockerImages pterodactyl.ImageCatalog `json:"docker_images,omitempty"`
+	// Images - Images offered by the egg, only used by PTDL_v1
+	Images []string `json:"images,omitempty"`
+	// Image - Single image of early PTDL_v1 files
+	Image        string     `json:"image,omitempty"`
+	FileDenylist []string   `json:"file_denylist"`
+	Startup      string     `json:"startup"`
+	Config       Config     `json:"config"`
+	Scripts      Scripts    `json:"scripts"`
+	Variables    []Variable `json:"variables"`
+}
+
+// Meta -
+type Meta struct {
+	Version   string  `json:"version"`
+	UpdateURL *string `json:"update_url"`
+}
+
+// Config - Egg configuration, the panel exports every section except stop as a JSON encoded string
+type Config struct {
+	Files   string `json:"files"`
+	Startup string `json:"startup"`
+	Logs    string `json:"logs"`
+	Stop    string `json:"stop"`
+}
+
+// Scripts -
+type Scripts struct {
+	Installation Installation `json:"installation"`
+}
+
+// Installation -
+type Installation struct {
+	Script     string `json:"script"`
+	Container  string `json:"container"`
+	Entrypoint string `json:"entrypoint"`
+}
+
+// Variable -
+type Variable struct {
+	Name         string           `json:"name"`
+	Description  string           `json:"description"`
+	EnvVariable  string           `json:"env_variable"`
+	DefaultValue string           `json:"default_value"`
+	UserViewable pterodactyl.Flag `json:"user_viewable"`
+	UserEditable pterodactyl.Flag `json:"user_editable"`
+	Rules        string           `json:"rules"`
+	FieldType    string           `json:"field_type,omitempty"`
+}
+
+// Parse - Reads an egg export file
+func Parse(r io.Reader) (*File, error) {
+	var file File
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, err
+	}
+
+	switch file.Meta.Version {
+	case VersionV1, VersionV2:
+	default:
+		return nil, fmt.Errorf("unsupported egg format version %q", file.Meta.Version)
+	}
+
+	return &file, nil
+}
+
+// Write - Writes an egg export file indented the way the panel exports it
+func Write(w io.Writer, file *File) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "    ")
+
+	return encoder.Encode(file)
+}
+
+// Convert - Returns a copy of the file in another format version
+func (f *File) Convert(version string) (*File, error) {
+	converted := *f
+	converted.Meta.Version = version
+
+	switch version {
+	case VersionV1:
+		converted.Images = f.images()
+		converted.Image = ""
+		converted.DockerImages = nil
+	case VersionV2:
+		converted.DockerImages = f.dockerImages()
+		converted.Images = nil
+		converted.Image = ""
+	default:
+		return nil, fmt.Errorf("unsupported egg format version %q", version)
+	}
+
+	return &converted, nil
+}
+
+// dockerImages - Images of the file keyed by label, PTDL_v1 images are labelled with themselves
+func (f *File) dockerImages() pterodactyl.ImageCatalog {
+	images := pterodactyl.ImageCatalog{}
+	for label, image := range f.DockerImages {
+		images[label] = image
+	}
+	for _, image := range f.Images {
+		images[image] = image
+	}
+	if f.Image != "" {
+		images[f.Image] = f.Image
+	}
+
+	return images
+}
+
+// images - Images of the file ordered by label
+func (f *File) images() []string {
+	dockerImages := f.dockerImages()
+
+	labels := make([]string, 0, len(dockerImages))
+	for label := range dockerImages {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	images := make([]string, len(labels))
+	for i, label := range labels {
+		images[i] = dockerImages[label]
+	}
+
+	return images
+}
+
+// UnmarshalJSON - Decodes a configuration, accepting sections written as objects instead of encoded strings
+func (c *Config) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Files   json.RawMessage `json:"files"`
+		Startup json.RawMessage `json:"startup"`
+		Logs    json.RawMessage `json:"logs"`
+		Stop    string          `json:"stop"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	config := Config{Stop: raw.Stop}
+	for _, section := range []struct {
+		raw    json.RawMessage
+		target *string
+	}{
+		{raw.Files, &config.Files},
+		{raw.Startup, &config.Startup},
+		{raw.Logs, &config.Logs},
+	} {
+		if len(section.raw) == 0 || string(section.raw) == "null" {
+			continue
+		}
+		if section.raw[0] == '"' {
+			if err := json.Unmarshal(section.raw, section.target); err != nil {
+				return err
+			}
+			continue
+		}
+		*section.target = string(section.raw)
+	}
+
+	*c = config
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package ptdl
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testEgg = `{
+    "_comment": "DO NOT EDIT: FILE GENERATED AUTOMATICALLY BY PTERODACTYL PANEL - PTERODACTYL.IO",
+    "meta": {
+        "version": "PTDL_v2",
+        "update_url": null
+    },
+    "exported_at": "2024-01-01T00:00:00+00:00",
+    "name": "Paper",
+    "author": "parker@pterodactyl.io",
+    "description": "High performance Spigot fork.",
+    "features": ["eula"],
+    "docker_images": {
+        "Java 17": "ghcr.io/pterodactyl/yolks:java_17",
+        "Java 21": "ghcr.io/pterodactyl/yolks:java_21"
+    },
+    "file_denylist": [],
+    "startup": "java -Xms128M -Xmx{{SERVER_MEMORY}}M -jar {{SERVER_JARFILE}}",
+    "config": {
+        "files": "{\"server.properties\":{\"parser\":\"properties\",\"find\":{\"server-port\":\"{{server.build.default.port}}\"}}}",
+        "startup": "{\"done\":\")! For help, type \"}",
+        "logs": "{}",
+        "stop": "stop"
+    },
+    "scripts": {
+        "installation": {
+            "script": "#!/bin/ash\r\necho install",
+            "container": "ghcr.io/pterodactyl/installers:alpine",
+            "entrypoint": "ash"
+        }
+    },
+    "variables": [
+        {
+            "name": "Server Jar File",
+            "description": "The name of the server jarfile to run the server with.",
+            "env_variable": "SERVER_JARFILE",
+            "default_value": "server.jar",
+            "user_viewable": true,
+            "user_editable": 1,
+            "rules": "required|regex:/^([\\w\\d._-]+)(\\.jar)$/",
+            "field_type": "text"
+        }
+    ]
+}`
+
+func parseTestEgg(t *testing.T) *File {
+	t.Helper()
+
+	file, err := Parse(strings.NewReader(testEgg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestParse(t *testing.T) {
+	file := parseTestEgg(t)
+
+	if file.Name != "Paper" || file.Meta.Version != VersionV2 {
+		t.Fatalf("unexpected file %+v", file)
+	}
+	if len(file.DockerImages) != 2 || file.DockerImages["Java 21"] != "ghcr.io/pterodactyl/yolks:java_21" {
+		t.Fatalf("unexpected docker images %v", file.DockerImages)
+	}
+	if len(file.Variables) != 1 || !bool(file.Variables[0].UserEditable) {
+		t.Fatalf("unexpected variables %+v", file.Variables)
+	}
+}
+
+func TestParseRejectsUnknownVersion(t *testing.T) {
+	if _, err := Parse(strings.NewReader(`{"meta":{"version":"PTDL_v3"}}`)); err == nil {
+		t.Fatal("expected an error for an unknown version")
+	}
+}
+
+func TestParseConfigObjects(t *testing.T) {
+	file, err := Parse(strings.NewReader(`{"meta":{"version":"PTDL_v1"},"config":{"files":{"a.txt":{"parser":"file","find":{}}},"startup":{"done":"ready"},"logs":[],"stop":"^C"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	egg, err := file.Egg()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if egg.Config.Files["a.txt"].Parser != "file" || egg.Config.Startup.Done != "ready" || egg.Config.Stop != "^C" {
+		t.Fatalf("unexpected config %+v", egg.Config)
+	}
+}
+
+func TestWriteRoundTrip(t *testing.T) {
+	file := parseTestEgg(t)
+
+	var written bytes.Buffer
+	if err := Write(&written, file); err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := Parse(&written)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(parsed, file) {
+		t.Fatalf("round trip changed the file\ngot  %+v\nwant %+v", parsed, file)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	file := parseTestEgg(t)
+
+	v1, err := file.Convert(VersionV1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantImages := []string{"ghcr.io/pterodactyl/yolks:java_17", "ghcr.io/pterodactyl/yolks:java_21"}
+	if v1.Meta.Version != VersionV1 || v1.DockerImages != nil || !reflect.DeepEqual(v1.Images, wantImages) {
+		t.Fatalf("unexpected PTDL_v1 file %+v", v1)
+	}
+
+	v2, err := v1.Convert(VersionV2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v2.Images != nil || v2.DockerImages["ghcr.io/pterodactyl/yolks:java_17"] != "ghcr.io/pterodactyl/yolks:java_17" {
+		t.Fatalf("unexpected PTDL_v2 file %+v", v2)
+	}
+
+	if _, err := file.Convert("PTDL_v3"); err == nil {
+		t.Fatal("expected an error for an unknown version")
+	}
+}
+
+func TestEggRoundTrip(t *testing.T) {
+	file := parseTestEgg(t)
+
+	egg, err := file.Egg()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exported, err := FromEgg(egg, VersionV2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	differences, err := Diff(exported, egg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(differences) > 0 {
+		t.Fatalf("exported egg differs from the egg: %+v", differences)
+	}
+
+	differences, err = Diff(file, egg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(differences) > 0 {
+		t.Fatalf("file differs from its own egg: %+v", differences)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	file := parseTestEgg(t)
+
+	egg, err := file.Egg()
+	if err != nil {
+		t.Fatal(err)
+	}
+	egg.Startup = "java -jar server.jar"
+	egg.Relationships.Variables.Data[0].Attributes.Rules = "required|string"
+	delete(egg.DockerImages, "Java 17")
+
+	differences, err := Diff(file, egg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fields := map[string]bool{}
+	for _, difference := range differences {
+		fields[difference.Field] = true
+	}
+	for _, field := range []string{"startup", "variables.SERVER_JARFILE.rules", "docker_images"} {
+		if !fields[field] {
+			t.Fatalf("expected a difference in %s, got %+v", field, differences)
+		}
+	}
+	if len(differences) != 3 {
+		t.Fatalf("expected 3 differences, got %+v", differences)
+	}
+}
@@ -0,0 +1,74 @@
+// Package wings talks to the Wings daemon of a node directly, without going through the panel
+package wings
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	pterodactyl "github.com/Lela810/pterodactyl-client-go"
+)
+
+// Client -
+type Client struct {
+	HostURL    string
+	HTTPClient *http.Client
+	Token      string
+}
+
+// NewClient - Creates a client for the daemon at host, authenticated with the token of the node configuration
+func NewClient(host string, config pterodactyl.NodeConfiguration) (*Client, error) {
+	if config.Token == "" {
+		return nil, fmt.Errorf("node configuration %s has no daemon token", config.UUID)
+	}
+
+	c := Client{
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		HostURL:    host,
+		Token:      config.Token,
+	}
+
+	return &c, nil
+}
+
+// NewClientForNode - Creates a client for the daemon of a node, reached at Scheme://FQDN:DaemonListen
+func NewClientForNode(node pterodactyl.Node, config pterodactyl.NodeConfiguration) (*Client, error) {
+	return NewClient(NodeURL(node), config)
+}
+
+// NodeURL - Returns the address the daemon of a node listens on
+func NodeURL(node pterodactyl.Node) string {
+	return fmt.Sprintf("%s://%s:%d", node.Scheme, node.FQDN, node.DaemonListen)
+}
+
+func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	statusOK := res.StatusCode >= 200 && res.StatusCode < 300
+	if !statusOK {
+		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+	}
+
+	return body, nil
+}
+
+func (c *Client) prepareBody(body interface{}) io.Reader {
+	b, _ := json.Marshal(body)
+	return bytes.NewReader(b)
+}
@@ -0,0 +1,216 @@
+package wings
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pterodactyl "github.com/Lela810/pterodactyl-client-go"
+)
+
+const testToken = "node-token"
+
+// newTestClient - Starts a stand-in daemon that rejects requests without the node token
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(server.URL, pterodactyl.NodeConfiguration{Token: testToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func TestNewClientRequiresToken(t *testing.T) {
+	if _, err := NewClient("http://localhost", pterodactyl.NodeConfiguration{}); err == nil {
+		t.Fatal("expected an error for a configuration without token")
+	}
+}
+
+func TestNodeURL(t *testing.T) {
+	node := pterodactyl.Node{Scheme: "https", FQDN: "node.example.com", DaemonListen: 8080}
+	if got := NodeURL(node); got != "https://node.example.com:8080" {
+		t.Fatalf("got %s", got)
+	}
+}
+
+func TestAuthorizationHeader(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{}`)
+	})
+
+	if _, err := client.GetSystemInformation(); err != nil {
+		t.Fatalf("request with the node token failed: %s", err)
+	}
+
+	client.Token = "wrong"
+	if _, err := client.GetSystemInformation(); err == nil {
+		t.Fatal("expected an error for a rejected token")
+	}
+}
+
+func TestGetSystemInformation(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/api/system" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		io.WriteString(w, `{"architecture":"amd64","cpu_count":8,"kernel_version":"6.1.0","os":"linux","version":"1.11.8"}`)
+	})
+
+	information, err := client.GetSystemInformation()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := SystemInformation{Architecture: "amd64", CPUCount: 8, KernelVersion: "6.1.0", OS: "linux", Version: "1.11.8"}
+	if information != want {
+		t.Fatalf("got %+v, want %+v", information, want)
+	}
+}
+
+const testServer = `{
+	"state": "running",
+	"is_suspended": false,
+	"utilization": {"memory_bytes": 1024, "cpu_absolute": 12.5, "network": {"rx_bytes": 1, "tx_bytes": 2}, "uptime": 60, "disk_bytes": 4096},
+	"configuration": {
+		"uuid": "d3aac109-e5a0-4331-b03e-3454f7e136dc",
+		"meta": {"name": "Survival"},
+		"environment": {"SERVER_JARFILE": "server.jar", "P_SERVER_ALLOCATION_LIMIT": 0, "BUILD_NUMBER": null},
+		"allocations": {"default": {"ip": "10.0.0.1", "port": 25565}, "mappings": {"10.0.0.1": [25565, 25566]}},
+		"build": {"memory_limit": 2048, "disk_space": 10240}
+	}
+}`
+
+func TestGetServers(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/servers" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		io.WriteString(w, "["+testServer+"]")
+	})
+
+	servers, err := client.GetServers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(servers) != 1 {
+		t.Fatalf("got %d servers", len(servers))
+	}
+
+	server := servers[0]
+	if server.State != "running" || server.Utilization.MemoryBytes != 1024 || server.Utilization.Network.TxBytes != 2 {
+		t.Fatalf("unexpected server %+v", server)
+	}
+	if server.Configuration.Allocations.Default.Port != 25565 || len(server.Configuration.Allocations.Mappings["10.0.0.1"]) != 2 {
+		t.Fatalf("unexpected allocations %+v", server.Configuration.Allocations)
+	}
+	if jar, ok := server.Configuration.Environment.GetString("SERVER_JARFILE"); !ok || jar != "server.jar" {
+		t.Fatalf("got SERVER_JARFILE %q", jar)
+	}
+	if limit, ok := server.Configuration.Environment.GetInt("P_SERVER_ALLOCATION_LIMIT"); !ok || limit != 0 {
+		t.Fatalf("got P_SERVER_ALLOCATION_LIMIT %d", limit)
+	}
+}
+
+func TestGetServer(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/servers/d3aac109-e5a0-4331-b03e-3454f7e136dc" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		io.WriteString(w, testServer)
+	})
+
+	server, err := client.GetServer("d3aac109-e5a0-4331-b03e-3454f7e136dc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.Configuration.Meta.Name != "Survival" || server.Configuration.Build.MemoryLimit != 2048 {
+		t.Fatalf("unexpected configuration %+v", server.Configuration)
+	}
+}
+
+func TestSetServerPowerState(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/servers/abc/power" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		if body["action"] != PowerActionRestart || body["wait_seconds"] != float64(30) {
+			t.Errorf("unexpected body %v", body)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	if err := client.SetServerPowerState("abc", PowerActionRestart, 30); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetServerLogs(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/servers/abc/logs" || r.URL.Query().Get("size") != "2" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		io.WriteString(w, `{"data":["[INFO] Starting","[INFO] Done"]}`)
+	})
+
+	lines, err := client.GetServerLogs("abc", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 2 || lines[1] != "[INFO] Done" {
+		t.Fatalf("got %v", lines)
+	}
+}
+
+func TestGetServerInstallStatus(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/servers/abc":
+			io.WriteString(w, `{"state":"offline","is_installing":true}`)
+		case "/api/servers/abc/install-logs":
+			io.WriteString(w, `{"data":"Installing dependencies\n"}`)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	status, err := client.GetServerInstallStatus("abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := InstallStatus{Installing: true, State: "offline", Log: "Installing dependencies\n"}
+	if status != want {
+		t.Fatalf("got %+v, want %+v", status, want)
+	}
+}
+
+func TestErrorStatus(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, `{"error":"The requested resource does not exist on this instance."}`)
+	})
+
+	if _, err := client.GetServer("missing"); err == nil {
+		t.Fatal("expected an error for a missing server")
+	}
+}
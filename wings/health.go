@@ -0,0 +1,186 @@
+package wings
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	pterodactyl "github.com/Lela810/pterodactyl-client-go"
+)
+
+// Stages of a node health check, reported as the stage that failed
+const (
+	HealthStageConfiguration = "configuration"
+	HealthStageTLS           = "tls"
+	HealthStageConnect       = "connect"
+	HealthStageAuthenticate  = "authenticate"
+	HealthStageSystem        = "system"
+)
+
+// NodeHealth - Outcome of probing the daemon of a node
+type NodeHealth struct {
+	Node pterodactyl.Node
+	URL  string
+	// TLS - The daemon is reached over https
+	TLS bool
+	// TLSVerified - The certificate of the daemon is trusted and matches the FQDN
+	TLSVerified bool
+	// TLSExpiry - Expiry of the certificate presented by the daemon
+	TLSExpiry time.Time
+	// Authenticated - The daemon accepted the token from the node configuration
+	Authenticated bool
+	System        SystemInformation
+	// Latency - Round trip of the system information request
+	Latency time.Duration
+	// FailedStage - Stage the check stopped at, empty when healthy
+	FailedStage string
+	Err         error
+}
+
+// Healthy - Returns whether every stage of the check passed
+func (h NodeHealth) Healthy() bool {
+	return h.Err == nil
+}
+
+// Version - Returns the version of the daemon
+func (h NodeHealth) Version() string {
+	return h.System.Version
+}
+
+func (h *NodeHealth) fail(stage string, err error) NodeHealth {
+	h.FailedStage = stage
+	h.Err = err
+	return *h
+}
+
+// CheckNodeHealth - Verifies that the daemon of a node is reachable, presents a valid certificate and accepts the node token
+func CheckNodeHealth(ctx context.Context, panel *pterodactyl.Client, node pterodactyl.Node) NodeHealth {
+	health := NodeHealth{
+		Node: node,
+		URL:  NodeURL(node),
+		TLS:  node.Scheme == "https",
+	}
+
+	config, err := panel.GetNodeConfiguration(node.ID)
+	if err != nil {
+		return health.fail(HealthStageConfiguration, err)
+	}
+
+	client, err := NewClient(health.URL, config)
+	if err != nil {
+		return health.fail(HealthStageConfiguration, err)
+	}
+
+	if health.TLS {
+		expiry, err := verifyTLS(ctx, node)
+		if err != nil {
+			return health.fail(HealthStageTLS, err)
+		}
+		health.TLSVerified = true
+		health.TLSExpiry = expiry
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/system", client.HostURL), nil)
+	if err != nil {
+		return health.fail(HealthStageConnect, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+client.Token)
+	req.Header.Set("Accept", "application/json")
+
+	start := time.Now()
+	res, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return health.fail(HealthStageConnect, err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	health.Latency = time.Since(start)
+	if err != nil {
+		return health.fail(HealthStageConnect, err)
+	}
+
+	switch {
+	case res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden:
+		return health.fail(HealthStageAuthenticate, fmt.Errorf("daemon rejected the node token, status: %d, body: %s", res.StatusCode, body))
+	case res.StatusCode < 200 || res.StatusCode >= 300:
+		return health.fail(HealthStageSystem, fmt.Errorf("status: %d, body: %s", res.StatusCode, body))
+	}
+	health.Authenticated = true
+
+	err = json.Unmarshal(body, &health.System)
+	if err != nil {
+		return health.fail(HealthStageSystem, err)
+	}
+
+	return health
+}
+
+// verifyTLS - Completes a verified handshake with the daemon and returns the expiry of its certificate
+func verifyTLS(ctx context.Context, node pterodactyl.Node) (time.Time, error) {
+	dialer := tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: 10 * time.Second},
+		Config:    &tls.Config{ServerName: node.FQDN},
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(node.FQDN, strconv.Itoa(int(node.DaemonListen))))
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer conn.Close()
+
+	certificates := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(certificates) == 0 {
+		return time.Time{}, fmt.Errorf("daemon %s presented no certificate", node.FQDN)
+	}
+
+	return certificates[0].NotAfter, nil
+}
+
+// CheckNodesHealth - Probes the daemons of several nodes with at most workers checks running at once
+//
+// The results are in the order of nodes.
+func CheckNodesHealth(ctx context.Context, panel *pterodactyl.Client, nodes []pterodactyl.Node, workers int) []NodeHealth {
+	if workers <= 0 {
+		workers = 4
+	}
+
+	results := make([]NodeHealth, len(nodes))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers && i < len(nodes); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				results[index] = CheckNodeHealth(ctx, panel, nodes[index])
+			}
+		}()
+	}
+
+	for index := range nodes {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
+// CheckAllNodesHealth - Probes the daemon of every node of the panel
+func CheckAllNodesHealth(ctx context.Context, panel *pterodactyl.Client, workers int) ([]NodeHealth, error) {
+	nodes, err := panel.GetNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	return CheckNodesHealth(ctx, panel, nodes, workers), nil
+}
@@ -0,0 +1,81 @@
+package wings
+
+import pterodactyl "github.com/Lela810/pterodactyl-client-go"
+
+// SystemInformation - Host the daemon runs on
+type SystemInformation struct {
+	Architecture  string `json:"architecture"`
+	CPUCount      int32  `json:"cpu_count"`
+	KernelVersion string `json:"kernel_version"`
+	OS            string `json:"os"`
+	Version       string `json:"version"`
+}
+
+// Server - Server as the daemon knows it
+type Server struct {
+	State         string              `json:"state"`
+	IsSuspended   bool                `json:"is_suspended"`
+	IsInstalling  bool                `json:"is_installing"`
+	Utilization   Utilization         `json:"utilization"`
+	Configuration ServerConfiguration `json:"configuration"`
+}
+
+// Utilization - Resource usage of a server
+type Utilization struct {
+	MemoryBytes      int64   `json:"memory_bytes"`
+	MemoryLimitBytes int64   `json:"memory_limit_bytes"`
+	CPUAbsolute      float64 `json:"cpu_absolute"`
+	Network          struct {
+		RxBytes int64 `json:"rx_bytes"`
+		TxBytes int64 `json:"tx_bytes"`
+	} `json:"network"`
+	Uptime    int64  `json:"uptime"`
+	State     string `json:"state"`
+	DiskBytes int64  `json:"disk_bytes"`
+}
+
+// ServerConfiguration - Configuration the daemon received from the panel for a server
+type ServerConfiguration struct {
+	UUID string `json:"uuid"`
+	Meta struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	} `json:"meta"`
+	Suspended   bool                    `json:"suspended"`
+	Invocation  string                  `json:"invocation"`
+	Environment pterodactyl.Environment `json:"environment"`
+	Allocations struct {
+		Default struct {
+			IP   string `json:"ip"`
+			Port int32  `json:"port"`
+		} `json:"default"`
+		Mappings map[string][]int32 `json:"mappings"`
+	} `json:"allocations"`
+	Build struct {
+		MemoryLimit int64  `json:"memory_limit"`
+		Swap        int64  `json:"swap"`
+		IOWeight    int32  `json:"io_weight"`
+		CPULimit    int64  `json:"cpu_limit"`
+		Threads     string `json:"threads"`
+		DiskSpace   int64  `json:"disk_space"`
+		OOMDisabled bool   `json:"oom_disabled"`
+	} `json:"build"`
+	Container struct {
+		Image string `json:"image"`
+	} `json:"container"`
+}
+
+// Power actions of the daemon
+const (
+	PowerActionStart   = "start"
+	PowerActionStop    = "stop"
+	PowerActionRestart = "restart"
+	PowerActionKill    = "kill"
+)
+
+// InstallStatus - Progress of the install script of a server
+type InstallStatus struct {
+	Installing bool
+	State      string
+	Log        string
+}
@@ -0,0 +1,147 @@
+package wings
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetSystemInformation - Returns information about the host the daemon runs on
+func (c *Client) GetSystemInformation() (SystemInformation, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/system", c.HostURL), nil)
+	if err != nil {
+		return SystemInformation{}, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return SystemInformation{}, err
+	}
+
+	var information SystemInformation
+	err = json.Unmarshal(body, &information)
+	if err != nil {
+		return SystemInformation{}, err
+	}
+
+	return information, nil
+}
+
+// GetServers - Returns list of servers on the node
+func (c *Client) GetServers() ([]Server, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/servers", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var servers []Server
+	err = json.Unmarshal(body, &servers)
+	if err != nil {
+		return nil, err
+	}
+
+	return servers, nil
+}
+
+// GetServer - Returns specific server on the node
+func (c *Client) GetServer(uuid string) (Server, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/servers/%s", c.HostURL, uuid), nil)
+	if err != nil {
+		return Server{}, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return Server{}, err
+	}
+
+	var server Server
+	err = json.Unmarshal(body, &server)
+	if err != nil {
+		return Server{}, err
+	}
+
+	return server, nil
+}
+
+// SetServerPowerState - Sends a power action to a server, waitSeconds bounds how long the daemon waits for a lock
+func (c *Client) SetServerPowerState(uuid string, action string, waitSeconds int32) error {
+	power := struct {
+		Action      string `json:"action"`
+		WaitSeconds int32  `json:"wait_seconds,omitempty"`
+	}{action, waitSeconds}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/servers/%s/power", c.HostURL, uuid), c.prepareBody(power))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetServerLogs - Returns the last lines of the console output of a server
+func (c *Client) GetServerLogs(uuid string, lines int32) ([]string, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/servers/%s/logs?size=%d", c.HostURL, uuid, lines), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Data []string `json:"data"`
+	}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetServerInstallStatus - Returns whether the install script of a server is running along with its output
+func (c *Client) GetServerInstallStatus(uuid string) (InstallStatus, error) {
+	server, err := c.GetServer(uuid)
+	if err != nil {
+		return InstallStatus{}, err
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/servers/%s/install-logs", c.HostURL, uuid), nil)
+	if err != nil {
+		return InstallStatus{}, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return InstallStatus{}, err
+	}
+
+	var response struct {
+		Data string `json:"data"`
+	}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return InstallStatus{}, err
+	}
+
+	status := InstallStatus{
+		Installing: server.IsInstalling,
+		State:      server.State,
+		Log:        response.Data,
+	}
+
+	return status, nil
+}